@@ -0,0 +1,364 @@
+package paystack
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const (
+	defaultBankDirectoryTTL = 24 * time.Hour
+
+	// bankDirectoryFailureTTL is how long a failed load is remembered, so
+	// that lookups during an outage don't each go back to the network
+	bankDirectoryFailureTTL = 30 * time.Second
+)
+
+// ErrBankNotFound is returned when no bank in the directory matches a lookup
+var ErrBankNotFound = errors.New("bank not found")
+
+// bankFillerWords are left out of acronyms, so "United Bank for Africa" is UBA
+var bankFillerWords = map[string]bool{
+	"the": true, "of": true, "for": true, "and": true,
+}
+
+// bankSuffixWords are left out when comparing bank names, so that
+// "GTBank" and "Guaranty Trust Bank Plc" reduce to comparable forms
+var bankSuffixWords = map[string]bool{
+	"bank": true, "plc": true, "ltd": true, "limited": true,
+}
+
+// BankDirectory caches the banks returned by Misc.ListBanks per country and
+// currency, and resolves bank codes from codes, slugs or free-form names
+// without a network round trip on every lookup.
+type BankDirectory struct {
+	client *Client
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[bankDirectoryKey]*bankDirectoryEntry
+	loads   map[bankDirectoryKey]*bankDirectoryLoad
+}
+
+type bankDirectoryKey struct {
+	country  string
+	currency string
+}
+
+type bankDirectoryEntry struct {
+	banks   []Bank
+	names   []bankName
+	err     error
+	expires time.Time
+}
+
+// bankDirectoryLoad is a load in flight, shared by every lookup of its key
+type bankDirectoryLoad struct {
+	done  chan struct{}
+	entry *bankDirectoryEntry
+}
+
+// bankName holds the normalized forms of a bank's name used for matching
+type bankName struct {
+	compact  string
+	slug     string
+	stripped string
+	initials string
+	acronym  string
+	core     string
+}
+
+// NewBankDirectory returns a directory that loads banks through client and
+// keeps them for ttl. A ttl of zero or less defaults to 24 hours.
+//
+//	client, _ := paystack.New(apiKey)
+//	banks := paystack.NewBankDirectory(client, 12*time.Hour)
+//	bank, err := banks.Match("nigeria", "NGN", "GTBank")
+func NewBankDirectory(client *Client, ttl time.Duration) *BankDirectory {
+	if ttl <= 0 {
+		ttl = defaultBankDirectoryTTL
+	}
+	return &BankDirectory{
+		client:  client,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[bankDirectoryKey]*bankDirectoryEntry),
+		loads:   make(map[bankDirectoryKey]*bankDirectoryLoad),
+	}
+}
+
+// Banks returns every bank for the country and currency, loading them
+// from Paystack when they are not cached or the cache has expired.
+// Either filter may be empty.
+func (d *BankDirectory) Banks(country, currency string) ([]Bank, error) {
+	entry, err := d.entry(country, currency)
+	if err != nil {
+		return nil, err
+	}
+	return append([]Bank(nil), entry.banks...), nil
+}
+
+// ByCode looks up a bank by its bank code, e.g. 058
+func (d *BankDirectory) ByCode(country, currency, code string) (*Bank, error) {
+	entry, err := d.entry(country, currency)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entry.banks {
+		if entry.banks[i].Code == code {
+			bank := entry.banks[i]
+			return &bank, nil
+		}
+	}
+	return nil, ErrBankNotFound
+}
+
+// BySlug looks up a bank by its slug, e.g. guaranty-trust-bank
+func (d *BankDirectory) BySlug(country, currency, slug string) (*Bank, error) {
+	entry, err := d.entry(country, currency)
+	if err != nil {
+		return nil, err
+	}
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	for i := range entry.banks {
+		if entry.banks[i].Slug == slug {
+			bank := entry.banks[i]
+			return &bank, nil
+		}
+	}
+	return nil, ErrBankNotFound
+}
+
+// Match finds the bank that best matches a free-form name such as
+// "GTBank", "Guaranty Trust", "UBA" or "zenith". Exact codes, slugs
+// and names win over acronyms, which win over prefixes, substrings
+// and finally close spellings.
+func (d *BankDirectory) Match(country, currency, name string) (*Bank, error) {
+	entry, err := d.entry(country, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	query := normalizeBankName(name)
+	if query.compact == "" {
+		return nil, ErrBankNotFound
+	}
+
+	best, bestScore := -1, 0.0
+	for i := range entry.banks {
+		score := matchBankName(query, strings.TrimSpace(name), &entry.banks[i], entry.names[i])
+		if score > bestScore || (score == bestScore && best >= 0 && !entry.banks[best].Active && entry.banks[i].Active) {
+			best, bestScore = i, score
+		}
+	}
+	if best < 0 {
+		return nil, ErrBankNotFound
+	}
+
+	bank := entry.banks[best]
+	return &bank, nil
+}
+
+// Invalidate drops every cached bank list
+func (d *BankDirectory) Invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = make(map[bankDirectoryKey]*bankDirectoryEntry)
+	d.loads = make(map[bankDirectoryKey]*bankDirectoryLoad)
+}
+
+// entry returns the cached banks for the country and currency. The lock
+// is only held to read and update the cache: the first lookup of a key
+// loads it from Paystack while later lookups of the same key wait for
+// that load, and lookups of other keys go on unblocked. A failed load is
+// kept for bankDirectoryFailureTTL before it is retried.
+func (d *BankDirectory) entry(country, currency string) (*bankDirectoryEntry, error) {
+	key := bankDirectoryKey{
+		country:  strings.ToLower(strings.TrimSpace(country)),
+		currency: strings.ToUpper(strings.TrimSpace(currency)),
+	}
+
+	d.mu.Lock()
+	if entry, ok := d.entries[key]; ok && d.now().Before(entry.expires) {
+		d.mu.Unlock()
+		return entry, entry.err
+	}
+	load, ok := d.loads[key]
+	if !ok {
+		load = &bankDirectoryLoad{done: make(chan struct{})}
+		d.loads[key] = load
+	}
+	d.mu.Unlock()
+
+	if ok {
+		<-load.done
+		return load.entry, load.entry.err
+	}
+
+	entry := d.newEntry(key)
+
+	d.mu.Lock()
+	// Invalidate may have run while loading, in which case the load is stale
+	if d.loads[key] == load {
+		d.entries[key] = entry
+		delete(d.loads, key)
+	}
+	d.mu.Unlock()
+
+	load.entry = entry
+	close(load.done)
+	return entry, entry.err
+}
+
+// newEntry loads the banks for the key and indexes their names
+func (d *BankDirectory) newEntry(key bankDirectoryKey) *bankDirectoryEntry {
+	banks, err := d.load(key)
+	if err != nil {
+		return &bankDirectoryEntry{err: err, expires: d.now().Add(bankDirectoryFailureTTL)}
+	}
+
+	entry := &bankDirectoryEntry{
+		banks:   banks,
+		names:   make([]bankName, len(banks)),
+		expires: d.now().Add(d.ttl),
+	}
+	for i := range banks {
+		entry.names[i] = normalizeBankName(banks[i].Name)
+		entry.names[i].slug = compactBankName(banks[i].Slug)
+	}
+	return entry
+}
+
+// load walks every page of Misc.ListBanks for the key
+func (d *BankDirectory) load(key bankDirectoryKey) ([]Bank, error) {
+	params := &ListBanksParams{
+		Country:   key.country,
+		Currency:  key.currency,
+		UseCursor: true,
+		PerPage:   100,
+	}
+
+	var banks []Bank
+	for {
+		page, err := d.client.Misc.ListBanks(params)
+		if err != nil {
+			return nil, err
+		}
+		banks = append(banks, page.Data...)
+
+		if page.Meta.Next == "" || page.Meta.Next == params.Next {
+			return banks, nil
+		}
+		params.Next = page.Meta.Next
+	}
+}
+
+func matchBankName(query bankName, raw string, bank *Bank, name bankName) float64 {
+	switch {
+	case raw == bank.Code:
+		return 100
+	case query.compact == name.compact || query.compact == name.slug:
+		return 95
+	case query.stripped != "" && query.stripped == name.stripped:
+		return 90
+	case len(query.compact) >= 2 && (query.compact == name.initials || query.compact == name.acronym):
+		return 80
+	case len(query.stripped) >= 2 && query.stripped == name.core:
+		return 80
+	case len(query.compact) >= 3 && strings.HasPrefix(name.compact, query.compact):
+		return 75
+	case len(query.stripped) >= 3 && strings.HasPrefix(name.stripped, query.stripped):
+		return 70
+	case len(query.stripped) >= 3 && strings.Contains(name.stripped, query.stripped):
+		return 60
+	}
+
+	if query.stripped == "" || name.stripped == "" {
+		return 0
+	}
+	distance := levenshtein(query.stripped, name.stripped)
+	longest := len(name.stripped)
+	if len(query.stripped) > longest {
+		longest = len(query.stripped)
+	}
+	similarity := 1 - float64(distance)/float64(longest)
+	if similarity < 0.75 {
+		return 0
+	}
+	return 50 * similarity
+}
+
+func normalizeBankName(name string) bankName {
+	words := strings.FieldsFunc(strings.ToLower(strings.ReplaceAll(name, "&", " and ")), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var compact, stripped, initials, acronym, core strings.Builder
+	for _, word := range words {
+		compact.WriteString(word)
+		initials.WriteByte(word[0])
+		if bankFillerWords[word] {
+			continue
+		}
+		acronym.WriteByte(word[0])
+		if bankSuffixWords[word] {
+			continue
+		}
+		stripped.WriteString(word)
+		core.WriteByte(word[0])
+	}
+
+	normalized := bankName{
+		compact:  compact.String(),
+		stripped: stripped.String(),
+		initials: initials.String(),
+		acronym:  acronym.String(),
+		core:     core.String(),
+	}
+
+	// "GTBank" or "FirstBank" carry the suffix glued to the name
+	if len(words) == 1 {
+		for word := range bankSuffixWords {
+			if len(normalized.stripped) > len(word) && strings.HasSuffix(normalized.stripped, word) {
+				normalized.stripped = strings.TrimSuffix(normalized.stripped, word)
+				break
+			}
+		}
+	}
+	return normalized
+}
+
+func compactBankName(name string) string {
+	return normalizeBankName(name).compact
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j] + 1
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+			if previous[j-1]+cost < current[j] {
+				current[j] = previous[j-1] + cost
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
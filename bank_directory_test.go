@@ -0,0 +1,182 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBankDirectory(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Query().Get("next") == "" {
+			_, _ = w.Write([]byte(`{"status":true,"data":[
+				{"name":"Access Bank","slug":"access-bank","code":"044","active":true},
+				{"name":"First Bank of Nigeria","slug":"first-bank-of-nigeria","code":"011","active":true},
+				{"name":"First City Monument Bank","slug":"first-city-monument-bank","code":"214","active":true}
+			],"meta":{"next":"cursor-2","perPage":3}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":true,"data":[
+			{"name":"Guaranty Trust Bank","slug":"guaranty-trust-bank","code":"058","active":true},
+			{"name":"United Bank For Africa","slug":"united-bank-for-africa","code":"033","active":true},
+			{"name":"Zenith Bank","slug":"zenith-bank","code":"057","active":true}
+		],"meta":{"next":null,"perPage":3}}`))
+	})
+
+	directory := NewBankDirectory(FromConfig(client), time.Hour)
+	now := time.Now()
+	directory.now = func() time.Time { return now }
+
+	t.Run("loads every page once", func(t *testing.T) {
+		banks, err := directory.Banks("nigeria", "NGN")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(banks) != 6 || calls != 2 {
+			t.Errorf("expected 6 banks from 2 calls, got %d banks from %d calls", len(banks), calls)
+		}
+	})
+
+	t.Run("lookup by code and slug", func(t *testing.T) {
+		bank, err := directory.ByCode("nigeria", "NGN", "057")
+		if err != nil || bank.Name != "Zenith Bank" {
+			t.Errorf("unexpected bank %+v, %v", bank, err)
+		}
+
+		bank, err = directory.BySlug("nigeria", "NGN", "Access-Bank")
+		if err != nil || bank.Code != "044" {
+			t.Errorf("unexpected bank %+v, %v", bank, err)
+		}
+
+		if _, err := directory.ByCode("nigeria", "NGN", "999"); !errors.Is(err, ErrBankNotFound) {
+			t.Errorf("expected ErrBankNotFound, got %v", err)
+		}
+	})
+
+	t.Run("fuzzy name match", func(t *testing.T) {
+		cases := map[string]string{
+			"GTBank":                   "058",
+			"GTB":                      "058",
+			"guaranty trust":           "058",
+			"UBA":                      "033",
+			"FCMB":                     "214",
+			"FirstBank":                "011",
+			"zenith":                   "057",
+			"Zenit Bank":               "057",
+			"Guaranty Trust Bank Plc.": "058",
+			"044":                      "044",
+		}
+		for name, code := range cases {
+			bank, err := directory.Match("nigeria", "NGN", name)
+			if err != nil {
+				t.Errorf("%q: %v", name, err)
+				continue
+			}
+			if bank.Code != code {
+				t.Errorf("%q: expected %s, got %s (%s)", name, code, bank.Code, bank.Name)
+			}
+		}
+
+		if _, err := directory.Match("nigeria", "NGN", "Wells Fargo"); !errors.Is(err, ErrBankNotFound) {
+			t.Errorf("expected ErrBankNotFound, got %v", err)
+		}
+	})
+
+	t.Run("reloads after the ttl", func(t *testing.T) {
+		calls = 0
+		now = now.Add(2 * time.Hour)
+		if _, err := directory.Banks("nigeria", "NGN"); err != nil {
+			t.Fatal(err)
+		}
+		if calls != 2 {
+			t.Errorf("expected the directory to reload, got %d calls", calls)
+		}
+	})
+}
+
+func TestBankDirectoryLoading(t *testing.T) {
+	var calls atomic.Int32
+	ghanaStarted, releaseGhana := make(chan struct{}), make(chan struct{})
+	failKenya := atomic.Bool{}
+	failKenya.Store(true)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		switch r.URL.Query().Get("country") {
+		case "ghana":
+			close(ghanaStarted)
+			<-releaseGhana
+		case "kenya":
+			if failKenya.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`{"status":false,"message":"unavailable"}`))
+				return
+			}
+		}
+		_, _ = w.Write([]byte(`{"status":true,"data":[
+			{"name":"Access Bank","slug":"access-bank","code":"044","active":true}
+		],"meta":{"next":null,"perPage":1}}`))
+	})
+
+	directory := NewBankDirectory(FromConfig(client), time.Hour)
+	var mu sync.Mutex
+	now := time.Now()
+	directory.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	t.Run("a slow load blocks neither other keys nor duplicates its request", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := directory.Banks("ghana", "GHS"); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		<-ghanaStarted
+
+		if _, err := directory.Banks("nigeria", "NGN"); err != nil {
+			t.Fatal(err)
+		}
+
+		close(releaseGhana)
+		wg.Wait()
+		if got := calls.Load(); got != 2 {
+			t.Errorf("expected one call per country, got %d calls", got)
+		}
+	})
+
+	t.Run("failures are cached briefly", func(t *testing.T) {
+		calls.Store(0)
+		for i := 0; i < 3; i++ {
+			var apiErr *APIError
+			if _, err := directory.Banks("kenya", "KES"); !errors.As(err, &apiErr) {
+				t.Fatalf("expected an APIError, got %v", err)
+			}
+		}
+		if got := calls.Load(); got != 1 {
+			t.Errorf("expected the failure to be cached, got %d calls", got)
+		}
+
+		failKenya.Store(false)
+		mu.Lock()
+		now = now.Add(bankDirectoryFailureTTL)
+		mu.Unlock()
+		if _, err := directory.Banks("kenya", "KES"); err != nil {
+			t.Fatal(err)
+		}
+		if got := calls.Load(); got != 2 {
+			t.Errorf("expected a retry after the failure expired, got %d calls", got)
+		}
+	})
+}
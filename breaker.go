@@ -0,0 +1,266 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling Paystack while the circuit
+// breaker of an endpoint group is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// BreakerState is the state of a circuit breaker
+type BreakerState int
+
+const (
+	// BreakerClosed lets every call through
+	BreakerClosed BreakerState = iota
+
+	// BreakerOpen fails every call with ErrCircuitOpen
+	BreakerOpen
+
+	// BreakerHalfOpen lets a few probe calls through to find out whether Paystack has recovered
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("BreakerState(%d)", int(s))
+}
+
+// BreakerSettings configures a CircuitBreaker. Zero values use the defaults.
+type BreakerSettings struct {
+	// FailureRatio: Ratio of failed calls in a window that opens the breaker. Defaults to 0.5
+	FailureRatio float64
+
+	// MinRequests: Calls needed in a window before the failure ratio is considered. Defaults to 10
+	MinRequests int
+
+	// Window: How long calls are counted for before the counts start over. Defaults to 60 seconds
+	Window time.Duration
+
+	// OpenTimeout: How long the breaker stays open before letting probe calls through. Defaults to 30 seconds
+	OpenTimeout time.Duration
+
+	// HalfOpenRequests: Probe calls that must succeed to close the breaker again. Defaults to 1
+	HalfOpenRequests int
+
+	// PerGroup: Keep a breaker per endpoint group (transaction, customer, ...)
+	// instead of one breaker for every call
+	PerGroup bool
+
+	// IsFailure: Decides whether a call counts as a failure. Defaults to calls
+	// that got no response and calls Paystack answered with a 5xx status code.
+	// Calls whose context was done before a response came back are not counted
+	IsFailure func(result *Result, err error) bool
+
+	// OnStateChange: Called whenever a breaker changes state. group is
+	// empty unless PerGroup is set
+	OnStateChange func(group string, from, to BreakerState)
+}
+
+// CircuitBreaker fails calls fast with ErrCircuitOpen while Paystack is
+// failing, instead of letting every call wait out the HTTP timeout.
+//
+//	client, _ := paystack.NewClient(apiKey)
+//	client.CircuitBreaker = paystack.NewCircuitBreaker(paystack.BreakerSettings{
+//		PerGroup: true,
+//		OnStateChange: func(group string, from, to paystack.BreakerState) {
+//			log.Printf("paystack %s breaker is %s", group, to)
+//		},
+//	})
+type CircuitBreaker struct {
+	settings BreakerSettings
+	now      func() time.Time
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+// breaker is the state of a single circuit
+type breaker struct {
+	state       BreakerState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probes      int
+	successes   int
+}
+
+// NewCircuitBreaker returns a circuit breaker with the given settings
+func NewCircuitBreaker(settings BreakerSettings) *CircuitBreaker {
+	if settings.FailureRatio <= 0 {
+		settings.FailureRatio = 0.5
+	}
+	if settings.MinRequests <= 0 {
+		settings.MinRequests = 10
+	}
+	if settings.Window <= 0 {
+		settings.Window = 60 * time.Second
+	}
+	if settings.OpenTimeout <= 0 {
+		settings.OpenTimeout = 30 * time.Second
+	}
+	if settings.HalfOpenRequests <= 0 {
+		settings.HalfOpenRequests = 1
+	}
+	if settings.IsFailure == nil {
+		settings.IsFailure = isBreakerFailure
+	}
+
+	return &CircuitBreaker{
+		settings: settings,
+		now:      time.Now,
+		breakers: make(map[string]*breaker),
+	}
+}
+
+// State returns the state of the breaker of an endpoint group, e.g.
+// transaction. The group is ignored unless PerGroup is set.
+func (cb *CircuitBreaker) State(group string) BreakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	b, ok := cb.breakers[cb.key(group)]
+	if !ok {
+		return BreakerClosed
+	}
+	if b.state == BreakerOpen && !cb.now().Before(b.openedAt.Add(cb.settings.OpenTimeout)) {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+func (cb *CircuitBreaker) key(group string) string {
+	if !cb.settings.PerGroup {
+		return ""
+	}
+	return group
+}
+
+// allow reports whether a call to the group may go through
+func (cb *CircuitBreaker) allow(group string) error {
+	cb.mu.Lock()
+	key := cb.key(group)
+	b, ok := cb.breakers[key]
+	if !ok {
+		b = &breaker{windowStart: cb.now()}
+		cb.breakers[key] = b
+	}
+
+	from := b.state
+	now := cb.now()
+	if b.state == BreakerOpen && !now.Before(b.openedAt.Add(cb.settings.OpenTimeout)) {
+		b.state = BreakerHalfOpen
+		b.probes, b.successes = 0, 0
+	}
+
+	var err error
+	switch b.state {
+	case BreakerOpen:
+		err = fmt.Errorf("%w until %s", ErrCircuitOpen, b.openedAt.Add(cb.settings.OpenTimeout).Format(time.RFC3339))
+	case BreakerHalfOpen:
+		if b.probes >= cb.settings.HalfOpenRequests {
+			err = fmt.Errorf("%w while probe calls are in flight", ErrCircuitOpen)
+		} else {
+			b.probes++
+		}
+	}
+	to := b.state
+	cb.mu.Unlock()
+
+	cb.notify(key, from, to)
+	return err
+}
+
+// release gives back the probe slot of a call whose outcome says nothing
+// about Paystack's health, e.g. one the caller cancelled
+func (cb *CircuitBreaker) release(group string) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	b := cb.breakers[cb.key(group)]
+	if b.state == BreakerHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
+
+// record counts the outcome of a call that allow let through
+func (cb *CircuitBreaker) record(group string, failed bool) {
+	cb.mu.Lock()
+	key := cb.key(group)
+	b := cb.breakers[key]
+	from := b.state
+	now := cb.now()
+
+	switch b.state {
+	case BreakerClosed:
+		if now.Sub(b.windowStart) >= cb.settings.Window {
+			b.windowStart, b.requests, b.failures = now, 0, 0
+		}
+		b.requests++
+		if failed {
+			b.failures++
+		}
+		if b.requests >= cb.settings.MinRequests && float64(b.failures)/float64(b.requests) >= cb.settings.FailureRatio {
+			b.state, b.openedAt = BreakerOpen, now
+		}
+	case BreakerHalfOpen:
+		if failed {
+			b.state, b.openedAt = BreakerOpen, now
+			break
+		}
+		b.successes++
+		if b.successes >= cb.settings.HalfOpenRequests {
+			b.state = BreakerClosed
+			b.windowStart, b.requests, b.failures = now, 0, 0
+		}
+	}
+	to := b.state
+	cb.mu.Unlock()
+
+	cb.notify(key, from, to)
+}
+
+func (cb *CircuitBreaker) notify(group string, from, to BreakerState) {
+	if from != to && cb.settings.OnStateChange != nil {
+		cb.settings.OnStateChange(group, from, to)
+	}
+}
+
+// middleware fails calls fast while the breaker of their endpoint group is open
+func (cb *CircuitBreaker) middleware(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*Result, error) {
+		group := endpointGroup(req.Path)
+		if err := cb.allow(group); err != nil {
+			return nil, err
+		}
+
+		result, err := next(ctx, req)
+		if result == nil && ctx.Err() != nil {
+			// the caller gave up before Paystack answered
+			cb.release(group)
+			return result, err
+		}
+		cb.record(group, cb.settings.IsFailure(result, err))
+		return result, err
+	}
+}
+
+func isBreakerFailure(result *Result, err error) bool {
+	if err == nil {
+		return false
+	}
+	return result == nil || result.StatusCode >= 500
+}
@@ -0,0 +1,86 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	failing := true
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if failing {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":true,"data":[]}`))
+	})
+
+	var changes []string
+	breaker := NewCircuitBreaker(BreakerSettings{
+		MinRequests: 4,
+		OpenTimeout: time.Minute,
+		PerGroup:    true,
+		OnStateChange: func(group string, from, to BreakerState) {
+			changes = append(changes, group+" "+from.String()+" -> "+to.String())
+		},
+	})
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+	client.CircuitBreaker = breaker
+
+	t.Run("opens once the failure ratio is reached", func(t *testing.T) {
+		for i := 0; i < 4; i++ {
+			if _, err := FromConfig(client).Misc.ListStates("CA"); errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("call %d: breaker opened too early", i)
+			}
+		}
+		if breaker.State("address_verification") != BreakerOpen {
+			t.Fatalf("expected the breaker to be open, got %s", breaker.State("address_verification"))
+		}
+
+		if _, err := FromConfig(client).Misc.ListStates("CA"); !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("expected ErrCircuitOpen, got %v", err)
+		}
+		if calls != 4 {
+			t.Errorf("expected the open breaker to fail fast, got %d calls", calls)
+		}
+	})
+
+	t.Run("other groups keep working", func(t *testing.T) {
+		failing = false
+		if _, err := FromConfig(client).Misc.ListCountries(); err != nil {
+			t.Errorf("expected the country group to be closed, got %v", err)
+		}
+	})
+
+	t.Run("closes after a successful probe", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		if breaker.State("address_verification") != BreakerHalfOpen {
+			t.Fatalf("expected the breaker to be half-open, got %s", breaker.State("address_verification"))
+		}
+		if _, err := FromConfig(client).Misc.ListStates("CA"); err != nil {
+			t.Fatal(err)
+		}
+		if breaker.State("address_verification") != BreakerClosed {
+			t.Errorf("expected the breaker to be closed, got %s", breaker.State("address_verification"))
+		}
+	})
+
+	expected := []string{
+		"address_verification closed -> open",
+		"address_verification open -> half-open",
+		"address_verification half-open -> closed",
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("unexpected state changes %v", changes)
+	}
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], changes[i])
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package checkout
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+// CallbackHandler verifies the transaction of the customer Paystack
+// redirected back, and checks they paid the amount and currency of its
+// order before calling OnSuccess.
+//
+// The callback can be opened more than once, e.g. when the customer
+// reloads the page, so fulfilling an order in OnSuccess must be idempotent.
+type CallbackHandler struct {
+	// Transactions: Verifies the transaction, e.g. ClientTransactions(client)
+	Transactions TransactionsFunc
+
+	// Orders: Looks up the order of the transaction reference
+	Orders OrderLookup
+
+	// Currency: Your integration currency, which orders without a currency
+	// are paid in. The payment of such an order fails with
+	// ErrMissingCurrency when it is not set
+	Currency paystack.Currency
+
+	// OnSuccess: Called once the order is paid, e.g. to fulfil it and
+	// redirect the customer to a receipt
+	OnSuccess func(w http.ResponseWriter, r *http.Request, order *Order, transaction *paystack.Transaction)
+
+	// OnFailure: Called when the order was not paid or cannot be verified.
+	// order and transaction are nil when they could not be found. Defaults
+	// to answering with the StatusCode of err
+	OnFailure func(w http.ResponseWriter, r *http.Request, order *Order, transaction *paystack.Transaction, err error)
+}
+
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	order, transaction, err := h.verify(r)
+	if err != nil {
+		if h.OnFailure != nil {
+			h.OnFailure(w, r, order, transaction, err)
+			return
+		}
+		writeError(w, err)
+		return
+	}
+
+	if h.OnSuccess == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	h.OnSuccess(w, r, order, transaction)
+}
+
+// verify returns the order of the callback and its transaction, which
+// is only returned without an error when it paid for the order
+func (h *CallbackHandler) verify(r *http.Request) (*Order, *paystack.Transaction, error) {
+	if h.Transactions == nil || h.Orders == nil {
+		return nil, nil, errors.New("checkout: CallbackHandler needs Transactions and Orders")
+	}
+
+	query := r.URL.Query()
+	reference := query.Get("reference")
+	if reference == "" {
+		reference = query.Get("trxref")
+	}
+	if reference == "" {
+		return nil, nil, ErrMissingReference
+	}
+
+	order, err := h.Orders.OrderByReference(r.Context(), reference)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot look up the order of transaction %s: %w", reference, err)
+	}
+	if order == nil {
+		return nil, nil, fmt.Errorf("%w: transaction %s", ErrOrderNotFound, reference)
+	}
+
+	response, err := h.Transactions(r.Context()).Verify(reference)
+	if err != nil {
+		return order, nil, fmt.Errorf("cannot verify transaction %s: %w", reference, err)
+	}
+	transaction, err := decodeTransaction(response)
+	if err != nil {
+		return order, nil, fmt.Errorf("cannot verify transaction %s: %w", reference, err)
+	}
+
+	currency := order.Currency
+	if currency == "" {
+		currency = h.Currency
+	}
+	return order, transaction, paid(order, currency, transaction)
+}
+
+// paid checks that transaction paid the amount of order in currency
+func paid(order *Order, currency paystack.Currency, transaction *paystack.Transaction) error {
+	switch {
+	case transaction.Reference != order.Reference:
+		return fmt.Errorf("%w: verified transaction %s instead of %s", ErrReferenceMismatch, transaction.Reference, order.Reference)
+	case transaction.Status != paystack.TransactionSuccess:
+		return fmt.Errorf("%w: transaction %s is %s", ErrNotPaid, transaction.Reference, transaction.Status)
+	case transaction.Amount != order.Amount:
+		return fmt.Errorf("%w: paid %d instead of %d", ErrAmountMismatch, transaction.Amount, order.Amount)
+	case currency == "":
+		return fmt.Errorf("%w: order %s", ErrMissingCurrency, order.ID)
+	case !strings.EqualFold(string(transaction.Currency), string(currency)):
+		return fmt.Errorf("%w: paid in %s instead of %s", ErrCurrencyMismatch, transaction.Currency, currency)
+	}
+	return nil
+}
+
+// decodeTransaction decodes the data of a verify response
+func decodeTransaction(response paystack.Response) (*paystack.Transaction, error) {
+	data, ok := response["data"].(map[string]any)
+	if !ok {
+		return nil, errors.New("response has no transaction")
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &paystack.Transaction{}
+	if err := json.Unmarshal(encoded, transaction); err != nil {
+		return nil, fmt.Errorf("cannot decode transaction: %w", err)
+	}
+	return transaction, nil
+}
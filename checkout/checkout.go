@@ -0,0 +1,147 @@
+// Package checkout provides the HTTP handlers of a Paystack checkout: one
+// that creates a transaction for an order and redirects the customer to
+// pay, and one that verifies the payment when Paystack redirects them back.
+//
+//	client, _ := paystack.New(apiKey)
+//	mux.Handle("/checkout", &checkout.InitializeHandler{
+//		Transactions: checkout.ClientTransactions(client),
+//		Orders: checkout.OrderResolverFunc(func(r *http.Request) (*checkout.Order, error) {
+//			return shop.CheckoutOrder(r.Context(), r.FormValue("order"))
+//		}),
+//	})
+//	mux.Handle("/checkout/callback", &checkout.CallbackHandler{
+//		Transactions: checkout.ClientTransactions(client),
+//		Orders: checkout.OrderLookupFunc(shop.OrderByReference),
+//		Currency: paystack.CurrencyNGN,
+//		OnSuccess: func(w http.ResponseWriter, r *http.Request, order *checkout.Order, transaction *paystack.Transaction) {
+//			http.Redirect(w, r, "/orders/"+order.ID, http.StatusSeeOther)
+//		},
+//	})
+package checkout
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+var (
+	// ErrOrderNotFound is returned by an OrderResolver or OrderLookup for an
+	// order that does not exist, and answered with a 404
+	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrMissingReference is reported when the callback has no reference
+	ErrMissingReference = errors.New("callback has no reference")
+
+	// ErrNotPaid is reported when the transaction of an order was not successful
+	ErrNotPaid = errors.New("transaction was not successful")
+
+	// ErrAmountMismatch is reported when a transaction paid another amount than the order
+	ErrAmountMismatch = errors.New("amount paid does not match the order")
+
+	// ErrCurrencyMismatch is reported when a transaction was paid in another currency than the order
+	ErrCurrencyMismatch = errors.New("currency paid does not match the order")
+
+	// ErrMissingCurrency is reported when neither the order nor the
+	// CallbackHandler sets the currency, so the payment cannot be checked
+	ErrMissingCurrency = errors.New("order has no currency to check the payment against")
+
+	// ErrReferenceMismatch is reported when Paystack verified another
+	// transaction than the one of the callback, and answered with a 502
+	ErrReferenceMismatch = errors.New("verified transaction does not match the callback")
+)
+
+// Order is what the customer pays for
+type Order struct {
+	// ID: Your ID of the order, for your own use in the callbacks
+	ID string
+
+	// Reference: Reference of the transaction of the order, which the
+	// callback looks the order up with. paystack.OrderReference derives one
+	// from the order ID
+	Reference string
+
+	// Email: Customer email address
+	Email string
+
+	// Amount: Amount in the subunit of the currency, e.g. kobo for NGN
+	Amount uint64
+
+	// Currency: Currency of the amount, defaults to your integration currency.
+	// The callback checks the payment against CallbackHandler.Currency
+	// when it is empty
+	Currency paystack.Currency
+
+	// CallbackURL: Optional url Paystack redirects the customer to, instead
+	// of the callback url set on the dashboard
+	CallbackURL string
+
+	// Metadata: Optional custom data kept with the transaction
+	Metadata *paystack.Metadata
+
+	// Channels: Optional payment channels the customer can pay with
+	Channels []paystack.Channel
+}
+
+// TransactionsFunc returns the transaction service a request is handled
+// with. It is called with the context of each request, so calls to
+// Paystack are cancelled with the request and carry its trace and the
+// key set with paystack.ContextWithAPIKey.
+type TransactionsFunc func(ctx context.Context) paystack.TransactionService
+
+// ClientTransactions returns client.Transactions bound to the context of each request
+func ClientTransactions(client *paystack.Client) TransactionsFunc {
+	return func(ctx context.Context) paystack.TransactionService {
+		return client.WithContext(ctx).Transactions
+	}
+}
+
+// OrderResolver returns the order a checkout request is for
+type OrderResolver interface {
+	ResolveOrder(r *http.Request) (*Order, error)
+}
+
+// OrderResolverFunc is an OrderResolver backed by a function
+type OrderResolverFunc func(r *http.Request) (*Order, error)
+
+func (f OrderResolverFunc) ResolveOrder(r *http.Request) (*Order, error) {
+	return f(r)
+}
+
+// OrderLookup returns the order a transaction reference belongs to
+type OrderLookup interface {
+	OrderByReference(ctx context.Context, reference string) (*Order, error)
+}
+
+// OrderLookupFunc is an OrderLookup backed by a function
+type OrderLookupFunc func(ctx context.Context, reference string) (*Order, error)
+
+func (f OrderLookupFunc) OrderByReference(ctx context.Context, reference string) (*Order, error) {
+	return f(ctx, reference)
+}
+
+// StatusCode returns the HTTP status code a checkout error is answered with
+func StatusCode(err error) int {
+	var apiErr *paystack.APIError
+	switch {
+	case errors.Is(err, ErrOrderNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrMissingReference):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrNotPaid), errors.Is(err, ErrAmountMismatch), errors.Is(err, ErrCurrencyMismatch):
+		return http.StatusPaymentRequired
+	case errors.Is(err, paystack.ErrReferenceExists):
+		return http.StatusConflict
+	case errors.Is(err, ErrReferenceMismatch), errors.As(err, &apiErr):
+		return http.StatusBadGateway
+	}
+	return http.StatusInternalServerError
+}
+
+// writeError answers a request with the status code of err
+func writeError(w http.ResponseWriter, err error) {
+	code := StatusCode(err)
+	http.Error(w, http.StatusText(code), code)
+}
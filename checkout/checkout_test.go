@@ -0,0 +1,325 @@
+package checkout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+	"github.com/rxxcc/paystack-go-sdk/paystacktest"
+)
+
+var testOrder = &Order{
+	ID:        "order-42",
+	Reference: "shop-7PZK4XQ2M9D1",
+	Email:     "customer@email.com",
+	Amount:    250000,
+	Currency:  paystack.CurrencyNGN,
+}
+
+// using returns a TransactionsFunc that handles every request with transactions
+func using(transactions paystack.TransactionService) TransactionsFunc {
+	return func(context.Context) paystack.TransactionService {
+		return transactions
+	}
+}
+
+func verified(status string, amount uint64, currency string) paystack.Response {
+	return paystack.Response{
+		"status": true,
+		"data": map[string]any{
+			"reference": testOrder.Reference,
+			"status":    status,
+			"amount":    float64(amount),
+			"currency":  currency,
+		},
+	}
+}
+
+func TestInitializeHandler(t *testing.T) {
+	t.Run("redirects to the authorization url", func(t *testing.T) {
+		transactions := paystacktest.NewTransactionService(t)
+		transactions.On("Initialize", paystacktest.MatchedBy(func(body *paystack.TransactionBody) bool {
+			return body.Amount == "250000" && body.Reference == testOrder.Reference && body.Email == testOrder.Email && body.Currency == paystack.CurrencyNGN
+		})).Return(paystack.Response{
+			"status": true,
+			"data":   map[string]any{"authorization_url": "https://checkout.paystack.com/0peioxfhpn"},
+		}, nil).Once()
+
+		handler := &InitializeHandler{
+			Transactions: using(transactions),
+			Orders: OrderResolverFunc(func(r *http.Request) (*Order, error) {
+				if r.FormValue("order") != testOrder.ID {
+					return nil, ErrOrderNotFound
+				}
+				return testOrder, nil
+			}),
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", "/checkout?order=order-42", nil))
+		if w.Code != http.StatusSeeOther || w.Header().Get("Location") != "https://checkout.paystack.com/0peioxfhpn" {
+			t.Errorf("got %d redirecting to %q", w.Code, w.Header().Get("Location"))
+		}
+
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", "/checkout?order=order-43", nil))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("got %d for an unknown order", w.Code)
+		}
+	})
+
+	t.Run("reports failed initializations", func(t *testing.T) {
+		transactions := paystacktest.NewTransactionService(t)
+		transactions.On("Initialize").Return(nil, &paystack.APIError{StatusCode: http.StatusBadRequest, Message: "Invalid Email Address Passed"})
+
+		var got error
+		handler := &InitializeHandler{
+			Transactions: using(transactions),
+			Orders: OrderResolverFunc(func(r *http.Request) (*Order, error) {
+				return testOrder, nil
+			}),
+			OnError: func(w http.ResponseWriter, r *http.Request, err error) {
+				got = err
+				w.WriteHeader(StatusCode(err))
+			},
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", "/checkout", nil))
+		var apiErr *paystack.APIError
+		if !errors.As(got, &apiErr) || w.Code != http.StatusBadGateway {
+			t.Errorf("got %d with error %v", w.Code, got)
+		}
+	})
+
+	t.Run("reports a reference that was already used", func(t *testing.T) {
+		transactions := paystacktest.NewTransactionService(t)
+		apiErr := &paystack.APIError{StatusCode: http.StatusBadRequest, Message: "Duplicate Transaction Reference"}
+		transactions.On("Initialize").Return(nil, fmt.Errorf("%w: %w", paystack.ErrReferenceExists, apiErr))
+
+		handler := &InitializeHandler{
+			Transactions: using(transactions),
+			Orders: OrderResolverFunc(func(r *http.Request) (*Order, error) {
+				return testOrder, nil
+			}),
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", "/checkout", nil))
+		if w.Code != http.StatusConflict {
+			t.Errorf("got %d for a duplicate reference", w.Code)
+		}
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type requestKey struct{}
+
+func TestClientTransactions(t *testing.T) {
+	client, err := paystack.New("sk_test_platform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Config.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if got := req.Header.Get("Authorization"); got != "Bearer sk_test_merchant" {
+			t.Errorf("expected the key of the request context, got %q", got)
+		}
+		if req.Context().Value(requestKey{}) != "checkout" {
+			t.Error("expected the call to be made with the request context")
+		}
+		body := `{"status":true,"data":{"reference":"shop-7PZK4XQ2M9D1","status":"success","amount":250000,"currency":"NGN"}}`
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body))}, nil
+	})}
+
+	handler := &CallbackHandler{
+		Transactions: ClientTransactions(client),
+		Orders: OrderLookupFunc(func(ctx context.Context, reference string) (*Order, error) {
+			return testOrder, nil
+		}),
+	}
+
+	ctx := paystack.ContextWithAPIKey(context.WithValue(context.Background(), requestKey{}, "checkout"), "sk_test_merchant")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/callback?reference=shop-7PZK4XQ2M9D1", nil).WithContext(ctx))
+	if w.Code != http.StatusNoContent {
+		t.Errorf("got status %d", w.Code)
+	}
+}
+
+func TestCallbackHandler(t *testing.T) {
+	orders := OrderLookupFunc(func(ctx context.Context, reference string) (*Order, error) {
+		if reference != testOrder.Reference {
+			return nil, ErrOrderNotFound
+		}
+		return testOrder, nil
+	})
+
+	tests := []struct {
+		name     string
+		target   string
+		response paystack.Response
+		wantErr  error
+		wantCode int
+	}{
+		{
+			name:     "paid",
+			target:   "/callback?trxref=shop-7PZK4XQ2M9D1&reference=shop-7PZK4XQ2M9D1",
+			response: verified("success", 250000, "NGN"),
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "reads trxref",
+			target:   "/callback?trxref=shop-7PZK4XQ2M9D1",
+			response: verified("success", 250000, "NGN"),
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "abandoned",
+			target:   "/callback?reference=shop-7PZK4XQ2M9D1",
+			response: verified("abandoned", 250000, "NGN"),
+			wantErr:  ErrNotPaid,
+			wantCode: http.StatusPaymentRequired,
+		},
+		{
+			name:     "underpaid",
+			target:   "/callback?reference=shop-7PZK4XQ2M9D1",
+			response: verified("success", 100, "NGN"),
+			wantErr:  ErrAmountMismatch,
+			wantCode: http.StatusPaymentRequired,
+		},
+		{
+			name:     "another currency",
+			target:   "/callback?reference=shop-7PZK4XQ2M9D1",
+			response: verified("success", 250000, "GHS"),
+			wantErr:  ErrCurrencyMismatch,
+			wantCode: http.StatusPaymentRequired,
+		},
+		{
+			name:     "no reference",
+			target:   "/callback",
+			wantErr:  ErrMissingReference,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "unknown order",
+			target:   "/callback?reference=shop-unknown",
+			wantErr:  ErrOrderNotFound,
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactions := paystacktest.NewTransactionService(t)
+			if tt.response != nil {
+				transactions.On("Verify", testOrder.Reference).Return(tt.response, nil).Once()
+			}
+
+			var gotErr error
+			var gotOrder *Order
+			var gotTransaction *paystack.Transaction
+			handler := &CallbackHandler{
+				Transactions: using(transactions),
+				Orders:       orders,
+				OnSuccess: func(w http.ResponseWriter, r *http.Request, order *Order, transaction *paystack.Transaction) {
+					gotOrder, gotTransaction = order, transaction
+					w.WriteHeader(http.StatusOK)
+				},
+				OnFailure: func(w http.ResponseWriter, r *http.Request, order *Order, transaction *paystack.Transaction, err error) {
+					gotOrder, gotTransaction, gotErr = order, transaction, err
+					w.WriteHeader(StatusCode(err))
+				},
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest("GET", tt.target, nil))
+
+			if w.Code != tt.wantCode {
+				t.Errorf("got status %d, want %d", w.Code, tt.wantCode)
+			}
+			if !errors.Is(gotErr, tt.wantErr) || (tt.wantErr == nil && gotErr != nil) {
+				t.Errorf("got error %v, want %v", gotErr, tt.wantErr)
+			}
+			if tt.response != nil && (gotOrder != testOrder || gotTransaction == nil || gotTransaction.Reference != testOrder.Reference) {
+				t.Errorf("got order %v and transaction %+v", gotOrder, gotTransaction)
+			}
+		})
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		transactions := paystacktest.NewTransactionService(t)
+		transactions.On("Verify", testOrder.Reference).Return(verified("failed", 250000, "NGN"), nil).Once()
+
+		handler := &CallbackHandler{Transactions: using(transactions), Orders: orders}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/callback?reference=shop-7PZK4XQ2M9D1", nil))
+		if w.Code != http.StatusPaymentRequired {
+			t.Errorf("got status %d", w.Code)
+		}
+	})
+
+	// callback serves a callback for order with Verify answering response
+	callback := func(t *testing.T, order *Order, currency paystack.Currency, response paystack.Response) (int, error) {
+		transactions := paystacktest.NewTransactionService(t)
+		transactions.On("Verify", order.Reference).Return(response, nil).Once()
+
+		var gotErr error
+		handler := &CallbackHandler{
+			Transactions: using(transactions),
+			Orders: OrderLookupFunc(func(ctx context.Context, reference string) (*Order, error) {
+				return order, nil
+			}),
+			Currency: currency,
+			OnFailure: func(w http.ResponseWriter, r *http.Request, order *Order, transaction *paystack.Transaction, err error) {
+				gotErr = err
+				w.WriteHeader(StatusCode(err))
+			},
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/callback?reference="+order.Reference, nil))
+		return w.Code, gotErr
+	}
+
+	t.Run("checks orders without a currency against the handler currency", func(t *testing.T) {
+		order := *testOrder
+		order.Currency = ""
+
+		if code, err := callback(t, &order, paystack.CurrencyNGN, verified("success", 250000, "USD")); code != http.StatusPaymentRequired || !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("got status %d with error %v", code, err)
+		}
+		if code, err := callback(t, &order, paystack.CurrencyNGN, verified("success", 250000, "NGN")); code != http.StatusNoContent || err != nil {
+			t.Errorf("got status %d with error %v", code, err)
+		}
+	})
+
+	t.Run("fails without any currency", func(t *testing.T) {
+		order := *testOrder
+		order.Currency = ""
+
+		code, err := callback(t, &order, "", verified("success", 250000, "NGN"))
+		if code != http.StatusInternalServerError || !errors.Is(err, ErrMissingCurrency) {
+			t.Errorf("got status %d with error %v", code, err)
+		}
+	})
+
+	t.Run("another transaction verified", func(t *testing.T) {
+		response := verified("success", 250000, "NGN")
+		response["data"].(map[string]any)["reference"] = "shop-other"
+
+		code, err := callback(t, testOrder, "", response)
+		if code != http.StatusBadGateway || !errors.Is(err, ErrReferenceMismatch) {
+			t.Errorf("got status %d with error %v", code, err)
+		}
+	})
+}
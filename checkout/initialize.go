@@ -0,0 +1,84 @@
+package checkout
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+// InitializeHandler initializes a transaction for the order of a request
+// and redirects the customer to the Paystack checkout to pay for it.
+//
+// Paystack refuses a second transaction with the same reference, so a
+// checkout started again for an order, e.g. after the customer abandoned
+// the first one, fails with paystack.ErrReferenceExists and is answered
+// with a 409. Give each attempt a fresh Order.Reference when orders can
+// be paid for again, and have the OrderLookup of the callback find the
+// order by any of them.
+type InitializeHandler struct {
+	// Transactions: Initializes the transaction, e.g. ClientTransactions(client)
+	Transactions TransactionsFunc
+
+	// Orders: Resolves the order of a request
+	Orders OrderResolver
+
+	// OnError: Optional handler of a failed checkout. Defaults to answering
+	// with the StatusCode of err
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func (h *InitializeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authorizationURL, err := h.initialize(r)
+	if err != nil {
+		if h.OnError != nil {
+			h.OnError(w, r, err)
+			return
+		}
+		writeError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, authorizationURL, http.StatusSeeOther)
+}
+
+// initialize resolves the order of r, initializes its transaction and
+// returns the url the customer pays at
+func (h *InitializeHandler) initialize(r *http.Request) (string, error) {
+	if h.Transactions == nil || h.Orders == nil {
+		return "", errors.New("checkout: InitializeHandler needs Transactions and Orders")
+	}
+
+	order, err := h.Orders.ResolveOrder(r)
+	if err != nil {
+		return "", fmt.Errorf("cannot resolve order: %w", err)
+	}
+	if order == nil {
+		return "", ErrOrderNotFound
+	}
+	if order.Reference == "" {
+		return "", fmt.Errorf("order %s has no reference", order.ID)
+	}
+
+	response, err := h.Transactions(r.Context()).Initialize(&paystack.TransactionBody{
+		Amount:      strconv.FormatUint(order.Amount, 10),
+		Email:       order.Email,
+		Currency:    order.Currency,
+		Reference:   order.Reference,
+		CallbackURL: order.CallbackURL,
+		Metadata:    order.Metadata,
+		Channels:    order.Channels,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot initialize transaction %s: %w", order.Reference, err)
+	}
+
+	data, _ := response["data"].(map[string]any)
+	authorizationURL, _ := data["authorization_url"].(string)
+	if authorizationURL == "" {
+		return "", fmt.Errorf("transaction %s has no authorization url", order.Reference)
+	}
+	return authorizationURL, nil
+}
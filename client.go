@@ -0,0 +1,100 @@
+package paystack
+
+import "context"
+
+// Client groups the Paystack APIs into services that share one Config,
+// and so one HTTP client, key, middleware and rate limits
+//
+//	client, err := paystack.New(apiKey)
+//	transaction, err := client.Transactions.Verify(reference)
+//	customer, err := client.Customers.Fetch(emailOrCode)
+type Client struct {
+	// Config: The configuration every service makes its calls with
+	Config *Config
+
+	// Transactions: Create and manage payments on your integration
+	Transactions *Transactions
+
+	// Customers: Create and manage customers on your integration
+	Customers *Customers
+
+	// Plans: Create and manage installment payment options on your integration
+	Plans *Plans
+
+	// Subscriptions: Create and manage recurring payments on your integration
+	Subscriptions *Subscriptions
+
+	// Splits: Split the settlement of transactions between your integration and subaccounts
+	Splits *Splits
+
+	// Misc: Supporting details such as the supported banks, countries and states
+	Misc *Misc
+
+	// Verification: Verify the details of customers before charging them
+	Verification *Verification
+}
+
+// Transactions is the Transactions API
+type Transactions struct{ config *Config }
+
+// Customers is the Customers API
+type Customers struct{ config *Config }
+
+// Plans is the Plans API
+type Plans struct{ config *Config }
+
+// Subscriptions is the Subscriptions API
+type Subscriptions struct{ config *Config }
+
+// Splits is the Transaction Splits API
+type Splits struct{ config *Config }
+
+// Misc is the Miscellaneous API
+type Misc struct{ config *Config }
+
+// Verification is the Verification API
+type Verification struct{ config *Config }
+
+// New instantiates a new paystack client
+//
+//	client, err := paystack.New(apiKey string)
+//	client, err := paystack.New(apiKey string, paystack.RequireTestMode())
+func New(apiKey string, opts ...Option) (*Client, error) {
+	config, err := NewClient(apiKey, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return FromConfig(config), nil
+}
+
+// FromConfig returns a client whose services make their calls with config
+func FromConfig(config *Config) *Client {
+	return &Client{
+		Config:        config,
+		Transactions:  &Transactions{config: config},
+		Customers:     &Customers{config: config},
+		Plans:         &Plans{config: config},
+		Subscriptions: &Subscriptions{config: config},
+		Splits:        &Splits{config: config},
+		Misc:          &Misc{config: config},
+		Verification:  &Verification{config: config},
+	}
+}
+
+// WithContext returns a copy of the client whose requests are made with ctx,
+// like Config.WithContext
+//
+//	client, _ := paystack.New(apiKey)
+//	transaction, err := client.WithContext(ctx).Transactions.Verify(reference)
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return FromConfig(c.Config.WithContext(ctx))
+}
+
+// WithAPIKey returns a copy of the client whose calls are made with key,
+// like Config.WithAPIKey
+//
+//	client, _ := paystack.New(platformKey)
+//	transaction, err := client.WithAPIKey(merchant.SecretKey).Transactions.Verify(reference)
+func (c *Client) WithAPIKey(key string) *Client {
+	return FromConfig(c.Config.WithAPIKey(key))
+}
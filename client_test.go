@@ -0,0 +1,118 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("services share the config", func(t *testing.T) {
+		var method, path string
+		var body map[string]any
+		config := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			method, path = r.Method, r.URL.Path
+			body = nil
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = w.Write([]byte(`{"status":true,"data":{"id":1}}`))
+		})
+		client := FromConfig(config)
+
+		response, err := client.Splits.AddSubaccount("SPL_98WF13Eb3w", &AddAndUpdateSplitSubaccountBody{Subaccount: "ACCT_8f4s1eq7ml6rlzj", Share: 20})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if method != "POST" || path != "/split/SPL_98WF13Eb3w/subaccount/add" {
+			t.Errorf("sent %s %s", method, path)
+		}
+		if body["subaccount"] != "ACCT_8f4s1eq7ml6rlzj" {
+			t.Errorf("sent body %v", body)
+		}
+		if response["status"] != true {
+			t.Errorf("got response %v", response)
+		}
+
+		if _, err := client.Customers.Fetch("CUS_xnxdt6s1zg1f4nx"); err != nil {
+			t.Fatal(err)
+		}
+		if method != "GET" || path != "/customer/CUS_xnxdt6s1zg1f4nx" {
+			t.Errorf("sent %s %s", method, path)
+		}
+	})
+
+	t.Run("responses are not shared between calls", func(t *testing.T) {
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/transaction/verify/ref-1" {
+				_, _ = w.Write([]byte(`{"status":true,"data":{"reference":"ref-1"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Transaction reference not found"}`))
+		}))
+
+		first, err := client.Transactions.Verify("ref-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := client.Transactions.Verify("ref-2")
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+
+		if first["status"] != true || first["data"] == nil {
+			t.Errorf("first response changed to %v", first)
+		}
+		if second["status"] != false || second["data"] != nil {
+			t.Errorf("got second response %v", second)
+		}
+	})
+
+	t.Run("deprecated methods call the services", func(t *testing.T) {
+		var path string
+		config := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			_, _ = w.Write([]byte(`{"status":true}`))
+		})
+
+		if _, err := config.RemoveSubAccountFromSplit(&RemoveSubAccountFromSplitBody{Subaccount: "ACCT_8f4s1eq7ml6rlzj"}, "SPL_98WF13Eb3w"); err != nil {
+			t.Fatal(err)
+		}
+		if path != "/split/SPL_98WF13Eb3w/subaccount/remove" {
+			t.Errorf("sent %s", path)
+		}
+	})
+
+	t.Run("deprecated methods added with the services call them too", func(t *testing.T) {
+		config := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/transaction/verify/order-42" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			_, _ = w.Write([]byte(`{"status":true,"data":{"reference":"order-42","status":"success"}}`))
+		})
+
+		transaction, err := config.WaitForTransaction(context.Background(), "order-42", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if transaction.Status != TransactionSuccess {
+			t.Errorf("unexpected transaction %+v", transaction)
+		}
+	})
+
+	t.Run("with context", func(t *testing.T) {
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":true}`))
+		}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := client.WithContext(ctx).Plans.Fetch("PLN_gx2wn530m0i3w3m"); !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want %v", err, context.Canceled)
+		}
+		if _, err := client.Plans.Fetch("PLN_gx2wn530m0i3w3m"); err != nil {
+			t.Errorf("original client got error %v", err)
+		}
+	})
+}
@@ -0,0 +1,703 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+// resources are the commands of each resource, e.g. paystack transaction verify
+var resources = map[string]map[string]command{
+	"transaction": {
+		"verify": {
+			args:    "<reference>",
+			summary: "Confirm the status of a transaction",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "reference"); err != nil {
+						return err
+					}
+					return r.print(r.client.Transactions.Verify(args[0]))()
+				}
+			},
+		},
+		"list": {
+			summary: "List the transactions carried out on your integration",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				page := listFlags(fs)
+				status := fs.String("status", "", "only list transactions with this status, e.g. success, failed or abandoned")
+				customer := fs.Uint64("customer", 0, "only list the transactions of the customer with this ID")
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					params, err := page()
+					if err != nil {
+						return err
+					}
+					transactions, err := r.client.Transactions.ListPage(&paystack.ListTransactionsParams{
+						ListParams: *params,
+						Status:     paystack.TransactionStatus(*status),
+						Customer:   *customer,
+					})
+					if err != nil {
+						return err
+					}
+					return r.out.print(transactions, "id", "reference", "status", "amount", "currency", "channel", "customer.email", "paid_at")
+				}
+			},
+		},
+		"fetch": {
+			args:    "<id>",
+			summary: "Get the details of a transaction",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "id"); err != nil {
+						return err
+					}
+					id, err := strconv.ParseUint(args[0], 10, 64)
+					if err != nil {
+						return fmt.Errorf("transaction id must be a number: %w", err)
+					}
+					return r.print(r.client.Transactions.Fetch(id))()
+				}
+			},
+		},
+		"timeline": {
+			args:    "<reference-or-id>",
+			summary: "View the timeline of a transaction",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "reference-or-id"); err != nil {
+						return err
+					}
+					return r.print(r.client.Transactions.Timeline(args[0]))()
+				}
+			},
+		},
+		"totals": {
+			summary: "Total amount received on your account",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					return r.print(r.client.Transactions.Totals())()
+				}
+			},
+		},
+		"export": {
+			summary: "Export transactions and print their rows, or the file url with -url",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				params := exportFlags(fs)
+				urlOnly := fs.Bool("url", false, "print the url of the exported file instead of its rows")
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					export, err := params()
+					if err != nil {
+						return err
+					}
+					if *urlOnly {
+						file, err := r.client.Transactions.ExportFile(export)
+						if err != nil {
+							return err
+						}
+						return r.out.print(file)
+					}
+					return r.export(export)
+				}
+			},
+		},
+	},
+	"customer": {
+		"create": {
+			summary: "Create a customer",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				body := &paystack.CreateCustomerBody{}
+				fs.StringVar(&body.Email, "email", "", "email address of the customer (required)")
+				fs.StringVar(&body.FirstName, "first-name", "", "first name of the customer")
+				fs.StringVar(&body.LastName, "last-name", "", "last name of the customer")
+				fs.StringVar(&body.Phone, "phone", "", "phone number of the customer")
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					return r.print(r.client.Customers.Create(body))()
+				}
+			},
+		},
+		"list": {
+			summary: "List the customers on your integration",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				page := listFlags(fs)
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					params, err := page()
+					if err != nil {
+						return err
+					}
+					return r.print(r.client.Customers.List(params))("id", "customer_code", "email", "first_name", "last_name", "risk_action")
+				}
+			},
+		},
+		"fetch": {
+			args:    "<email-or-code>",
+			summary: "Get the details of a customer",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "email-or-code"); err != nil {
+						return err
+					}
+					return r.print(r.client.Customers.Fetch(args[0]))()
+				}
+			},
+		},
+		"update": {
+			args:    "<code>",
+			summary: "Update the details of a customer, keeping the fields not given",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				firstName := fs.String("first-name", "", "first name of the customer")
+				lastName := fs.String("last-name", "", "last name of the customer")
+				phone := fs.String("phone", "", "phone number of the customer")
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "code"); err != nil {
+						return err
+					}
+					current, err := r.fetch(r.client.Customers.Fetch(args[0]))
+					if err != nil {
+						return err
+					}
+					body := &paystack.UpdateCustomerBody{
+						FirstName: stringOr(fs, "first-name", *firstName, current["first_name"]),
+						LastName:  stringOr(fs, "last-name", *lastName, current["last_name"]),
+						Phone:     stringOr(fs, "phone", *phone, current["phone"]),
+					}
+					return r.print(r.client.Customers.Update(args[0], body))()
+				}
+			},
+		},
+		"risk": {
+			args:    "<email-or-code> <allow|deny|default>",
+			summary: "Whitelist, blacklist or reset the risk action of a customer",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "email-or-code", "risk-action"); err != nil {
+						return err
+					}
+					return r.print(r.client.Customers.SetRiskAction(&paystack.WhiteListOrBlacklistCustomerBody{
+						Customer:   args[0],
+						RiskAction: paystack.RiskAction(args[1]),
+					}))()
+				}
+			},
+		},
+	},
+	"plan": {
+		"create": {
+			summary: "Create a plan",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				plan := planFlags(fs)
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					return r.print(r.client.Plans.Create(plan))()
+				}
+			},
+		},
+		"list": {
+			summary: "List the plans on your integration",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				page := listFlags(fs)
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					params, err := page()
+					if err != nil {
+						return err
+					}
+					return r.print(r.client.Plans.List(params))("id", "plan_code", "name", "amount", "interval", "currency")
+				}
+			},
+		},
+		"fetch": {
+			args:    "<id-or-code>",
+			summary: "Get the details of a plan",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "id-or-code"); err != nil {
+						return err
+					}
+					return r.print(r.client.Plans.Fetch(args[0]))()
+				}
+			},
+		},
+		"update": {
+			args:    "<id-or-code>",
+			summary: "Update a plan, keeping the fields not given",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				changes := planFlags(fs)
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "id-or-code"); err != nil {
+						return err
+					}
+					current, err := r.fetch(r.client.Plans.Fetch(args[0]))
+					if err != nil {
+						return err
+					}
+					plan := &paystack.Plan{}
+					if err := decodeInto(current, plan); err != nil {
+						return err
+					}
+					fs.Visit(func(f *flag.Flag) {
+						applyPlanFlag(plan, changes, f.Name)
+					})
+					return r.print(r.client.Plans.Update(args[0], plan))()
+				}
+			},
+		},
+	},
+	"subscription": {
+		"create": {
+			summary: "Subscribe a customer to a plan",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				body := &paystack.CreateSubscriptionBody{}
+				fs.StringVar(&body.Customer, "customer", "", "email address or code of the customer (required)")
+				fs.StringVar(&body.Plan, "plan", "", "code of the plan (required)")
+				fs.StringVar(&body.Authorization, "authorization", "", "authorization code to charge, defaults to the most recent one")
+				start := fs.String("start", "", "date of the first debit, e.g. 2024-05-16 or 2024-05-16T00:30:13+01:00")
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					startDate, err := parseTime(*start)
+					if err != nil {
+						return fmt.Errorf("invalid -start: %w", err)
+					}
+					body.StartDate = startDate
+					return r.print(r.client.Subscriptions.Create(body))()
+				}
+			},
+		},
+		"list": {
+			summary: "List the subscriptions on your integration",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				page := listFlags(fs)
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					params, err := page()
+					if err != nil {
+						return err
+					}
+					return r.print(r.client.Subscriptions.List(params))("id", "subscription_code", "status", "amount", "plan.name", "customer.email", "next_payment_date")
+				}
+			},
+		},
+		"fetch": {
+			args:    "<id-or-code>",
+			summary: "Get the details of a subscription",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "id-or-code"); err != nil {
+						return err
+					}
+					return r.print(r.client.Subscriptions.Fetch(args[0]))()
+				}
+			},
+		},
+		"enable": {
+			args:    "<code>",
+			summary: "Enable a subscription",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "code"); err != nil {
+						return err
+					}
+					return r.print(r.client.Subscriptions.EnableByCode(args[0]))()
+				}
+			},
+		},
+		"disable": {
+			args:    "<code>",
+			summary: "Disable a subscription",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "code"); err != nil {
+						return err
+					}
+					return r.print(r.client.Subscriptions.DisableByCode(args[0]))()
+				}
+			},
+		},
+		"link": {
+			args:    "<code>",
+			summary: "Generate a link for updating the card on a subscription, or email it with -send",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				send := fs.Bool("send", false, "email the link to the customer")
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "code"); err != nil {
+						return err
+					}
+					if *send {
+						return r.print(r.client.Subscriptions.SendUpdateLink(args[0]))()
+					}
+					return r.print(r.client.Subscriptions.GenerateUpdateLink(args[0]))()
+				}
+			},
+		},
+	},
+	"split": {
+		"create": {
+			summary: "Create a transaction split",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				body := &paystack.CreateSplitBody{}
+				fs.StringVar(&body.Name, "name", "", "name of the split (required)")
+				fs.Func("type", "percentage or flat (required)", func(value string) error {
+					body.Type = paystack.SplitType(value)
+					return nil
+				})
+				fs.Func("currency", "currency of the split, e.g. NGN (required)", func(value string) error {
+					body.Currency = paystack.Currency(strings.ToUpper(value))
+					return nil
+				})
+				fs.Func("subaccount", "subaccount and share as CODE:SHARE, repeat for each subaccount", func(value string) error {
+					code, share, err := subaccountShare(value)
+					if err != nil {
+						return err
+					}
+					body.Subaccounts = append(body.Subaccounts, map[string]any{"subaccount": code, "share": share})
+					return nil
+				})
+				bearer := bearerFlags(fs)
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					body.BearerType, body.BearerSubAccount = bearer()
+					return r.print(r.client.Splits.Create(body))()
+				}
+			},
+		},
+		"list": {
+			summary: "List the transaction splits on your integration",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				page := listFlags(fs)
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args); err != nil {
+						return err
+					}
+					params, err := page()
+					if err != nil {
+						return err
+					}
+					return r.print(r.client.Splits.List(params))("id", "split_code", "name", "type", "currency", "active")
+				}
+			},
+		},
+		"fetch": {
+			args:    "<id>",
+			summary: "Get the details of a transaction split",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "id"); err != nil {
+						return err
+					}
+					return r.print(r.client.Splits.Fetch(args[0]))()
+				}
+			},
+		},
+		"update": {
+			args:    "<id>",
+			summary: "Update a transaction split, keeping the fields not given",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				name := fs.String("name", "", "name of the split")
+				active := fs.Bool("active", true, "whether the split is active")
+				bearer := bearerFlags(fs)
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "id"); err != nil {
+						return err
+					}
+					current, err := r.fetch(r.client.Splits.Fetch(args[0]))
+					if err != nil {
+						return err
+					}
+					currentActive, _ := current["active"].(bool)
+					body := &paystack.UpdateSplitBody{
+						Name:   stringOr(fs, "name", *name, current["name"]),
+						Active: currentActive,
+					}
+					if visited(fs, "active") {
+						body.Active = *active
+					}
+					bearerType, bearerSubaccount := bearer()
+					if visited(fs, "bearer-type") {
+						body.BearerType = bearerType
+					}
+					if visited(fs, "bearer-subaccount") {
+						body.BearerSubAccount = bearerSubaccount
+					}
+					return r.print(r.client.Splits.Update(args[0], body))()
+				}
+			},
+		},
+		"add-subaccount": {
+			args:    "<id> <subaccount> <share>",
+			summary: "Add a subaccount to a split, or update its share",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "id", "subaccount", "share"); err != nil {
+						return err
+					}
+					_, share, err := subaccountShare(args[1] + ":" + args[2])
+					if err != nil {
+						return err
+					}
+					return r.print(r.client.Splits.AddSubaccount(args[0], &paystack.AddAndUpdateSplitSubaccountBody{
+						Subaccount: args[1],
+						Share:      share,
+					}))()
+				}
+			},
+		},
+		"remove-subaccount": {
+			args:    "<id> <subaccount>",
+			summary: "Remove a subaccount from a split",
+			flags: func(fs *flag.FlagSet) func(*runner, []string) error {
+				return func(r *runner, args []string) error {
+					if err := wantArgs(args, "id", "subaccount"); err != nil {
+						return err
+					}
+					return r.print(r.client.Splits.RemoveSubaccount(args[0], &paystack.RemoveSubAccountFromSplitBody{Subaccount: args[1]}))()
+				}
+			},
+		},
+	},
+}
+
+// print writes the response of a call that returns a Response. Call the
+// returned function with the columns of a list to show them in a table.
+func (r *runner) print(response paystack.Response, err error) printer {
+	return func(columns ...string) error {
+		if err != nil {
+			return err
+		}
+		return r.out.print(response, columns...)
+	}
+}
+
+// printer prints a response with the given table columns
+type printer func(columns ...string) error
+
+// fetch returns the data of a response, used to fill in the fields an update leaves out
+func (r *runner) fetch(response paystack.Response, err error) (map[string]any, error) {
+	if err != nil {
+		return nil, err
+	}
+	data, _ := response["data"].(map[string]any)
+	if data == nil {
+		return nil, fmt.Errorf("unexpected response %v", response)
+	}
+	return data, nil
+}
+
+// export streams the rows of an export, as a JSON object per line or as a table
+func (r *runner) export(params *paystack.ExportTransactionsParams) error {
+	rows, err := r.client.Transactions.ExportRows(params)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if r.out.format == "json" {
+		encoder := json.NewEncoder(r.out.w)
+		for rows.Next() {
+			if err := encoder.Encode(rows.Row().Columns); err != nil {
+				return err
+			}
+		}
+		return rows.Err()
+	}
+
+	tw := tabwriter.NewWriter(r.out.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "REFERENCE\tSTATUS\tAMOUNT\tCURRENCY\tCHANNEL\tEMAIL\tPAID_AT")
+	for rows.Next() {
+		row := rows.Row()
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", row.Reference, row.Status, row.Amount, row.Currency, row.Channel, row.CustomerEmail, row.PaidAt)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return tw.Flush()
+}
+
+// listFlags registers the pagination flags of a list command
+func listFlags(fs *flag.FlagSet) func() (*paystack.ListParams, error) {
+	perPage := fs.Int("per-page", 0, "number of records per page, defaults to 50")
+	page := fs.Int("page", 0, "page to return, defaults to 1")
+	from := fs.String("from", "", "only list records created from this date, e.g. 2024-01-01")
+	to := fs.String("to", "", "only list records created up to this date")
+	return func() (*paystack.ListParams, error) {
+		params := &paystack.ListParams{PerPage: *perPage, Page: *page}
+		var err error
+		if params.From, err = parseTime(*from); err != nil {
+			return nil, fmt.Errorf("invalid -from: %w", err)
+		}
+		if params.To, err = parseTime(*to); err != nil {
+			return nil, fmt.Errorf("invalid -to: %w", err)
+		}
+		return params, nil
+	}
+}
+
+// exportFlags registers the filters of transaction export
+func exportFlags(fs *flag.FlagSet) func() (*paystack.ExportTransactionsParams, error) {
+	from := fs.String("from", "", "only export transactions made from this date, e.g. 2024-01-01")
+	to := fs.String("to", "", "only export transactions made up to this date")
+	status := fs.String("status", "", "only export transactions with this status")
+	currency := fs.String("currency", "", "only export transactions in this currency")
+	settled := fs.String("settled", "", "true to export only settled transactions, false for only unsettled ones")
+	settlement := fs.Uint64("settlement", 0, "only export the transactions of this settlement ID")
+	paymentPage := fs.Uint64("payment-page", 0, "only export the transactions of this payment page ID")
+	customer := fs.Uint64("customer", 0, "only export the transactions of this customer ID")
+	return func() (*paystack.ExportTransactionsParams, error) {
+		params := &paystack.ExportTransactionsParams{
+			Status:      paystack.TransactionStatus(*status),
+			Currency:    paystack.Currency(strings.ToUpper(*currency)),
+			Settlement:  *settlement,
+			PaymentPage: *paymentPage,
+			Customer:    *customer,
+		}
+		var err error
+		if params.From, err = parseTime(*from); err != nil {
+			return nil, fmt.Errorf("invalid -from: %w", err)
+		}
+		if params.To, err = parseTime(*to); err != nil {
+			return nil, fmt.Errorf("invalid -to: %w", err)
+		}
+		if *settled != "" {
+			value, err := strconv.ParseBool(*settled)
+			if err != nil {
+				return nil, fmt.Errorf("invalid -settled: %w", err)
+			}
+			params.Settled = &value
+		}
+		return params, nil
+	}
+}
+
+// planFlags registers the fields of a plan
+func planFlags(fs *flag.FlagSet) *paystack.Plan {
+	plan := &paystack.Plan{SendInvoices: true, SendSMS: true}
+	fs.StringVar(&plan.Name, "name", "", "name of the plan")
+	fs.Uint64Var(&plan.Amount, "amount", 0, "amount in the subunit of the currency, e.g. kobo")
+	fs.Func("interval", "hourly, daily, weekly, monthly, quarterly, biannually or annually", func(value string) error {
+		plan.Interval = paystack.Interval(value)
+		return nil
+	})
+	fs.Func("currency", "currency of the amount, e.g. NGN", func(value string) error {
+		plan.Currency = paystack.Currency(strings.ToUpper(value))
+		return nil
+	})
+	fs.StringVar(&plan.Description, "description", "", "description of the plan")
+	fs.Uint64Var(&plan.InvoiceLimit, "invoice-limit", 0, "number of invoices to raise during a subscription")
+	fs.BoolVar(&plan.SendInvoices, "send-invoices", true, "send invoices to customers")
+	fs.BoolVar(&plan.SendSMS, "send-sms", true, "send text messages to customers")
+	return plan
+}
+
+// applyPlanFlag copies the field set by a plan flag from changes to plan
+func applyPlanFlag(plan, changes *paystack.Plan, name string) {
+	switch name {
+	case "name":
+		plan.Name = changes.Name
+	case "amount":
+		plan.Amount = changes.Amount
+	case "interval":
+		plan.Interval = changes.Interval
+	case "currency":
+		plan.Currency = changes.Currency
+	case "description":
+		plan.Description = changes.Description
+	case "invoice-limit":
+		plan.InvoiceLimit = changes.InvoiceLimit
+	case "send-invoices":
+		plan.SendInvoices = changes.SendInvoices
+	case "send-sms":
+		plan.SendSMS = changes.SendSMS
+	}
+}
+
+// bearerFlags registers who bears the charges of a split
+func bearerFlags(fs *flag.FlagSet) func() (paystack.BearerType, string) {
+	bearerType := fs.String("bearer-type", "", "who bears the charges: subaccount, account, all-proportional or all")
+	bearerSubaccount := fs.String("bearer-subaccount", "", "code of the subaccount bearing the charges")
+	return func() (paystack.BearerType, string) {
+		return paystack.BearerType(*bearerType), *bearerSubaccount
+	}
+}
+
+// subaccountShare parses CODE:SHARE
+func subaccountShare(value string) (string, uint64, error) {
+	code, share, ok := strings.Cut(value, ":")
+	if !ok || code == "" {
+		return "", 0, fmt.Errorf("expected CODE:SHARE, got %q", value)
+	}
+	amount, err := strconv.ParseUint(share, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("share of %s must be a number: %w", code, err)
+	}
+	return code, amount, nil
+}
+
+// parseTime parses a date or an RFC 3339 time, returning the zero time for an empty value
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// visited reports whether a flag was given on the command line
+func visited(fs *flag.FlagSet, name string) bool {
+	found := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			found = true
+		}
+	})
+	return found
+}
+
+// stringOr returns the value of a flag when it was given and current otherwise
+func stringOr(fs *flag.FlagSet, name, value string, current any) string {
+	if visited(fs, name) {
+		return value
+	}
+	s, _ := current.(string)
+	return s
+}
+
+// decodeInto converts the data of a response to a typed body
+func decodeInto(data map[string]any, out any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, out)
+}
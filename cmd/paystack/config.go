@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// fileConfig is the config file of the command
+type fileConfig struct {
+	// SecretKey: Secret key of the integration, e.g. sk_test_xxxx
+	SecretKey string `json:"secret_key"`
+}
+
+// secretKey reads the secret key from PAYSTACK_SECRET_KEY, falling back to
+// the config file at path, PAYSTACK_CONFIG or the default config file
+func secretKey(path string) (string, error) {
+	if key := os.Getenv("PAYSTACK_SECRET_KEY"); key != "" && path == "" {
+		return key, nil
+	}
+
+	explicit := path != ""
+	if path == "" {
+		path = os.Getenv("PAYSTACK_CONFIG")
+		explicit = path != ""
+	}
+	if path == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return "", errors.New("no secret key, set PAYSTACK_SECRET_KEY or use -config")
+		}
+		path = filepath.Join(dir, "paystack", "config.json")
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return "", errors.New("no secret key, set PAYSTACK_SECRET_KEY or create " + path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot read config file: %w", err)
+	}
+
+	config := fileConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", fmt.Errorf("cannot decode config file %s: %w", path, err)
+	}
+	if config.SecretKey == "" {
+		return "", fmt.Errorf("config file %s has no secret_key", path)
+	}
+	return config.SecretKey, nil
+}
@@ -0,0 +1,156 @@
+// Command paystack calls the Paystack API from the command line.
+//
+//	go install github.com/rxxcc/paystack-go-sdk/cmd/paystack@latest
+//
+//	export PAYSTACK_SECRET_KEY=sk_test_xxxx
+//	paystack transaction verify dm9jdrejvp
+//	paystack transaction list -status success -per-page 20 -o table
+//	paystack customer risk CUS_xnxdt6s1zg1f4nx deny
+//
+// The secret key is read from PAYSTACK_SECRET_KEY, or from the secret_key
+// of a JSON config file given with -config or PAYSTACK_CONFIG, which
+// defaults to paystack/config.json in the user config directory.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+// command is an action on a resource, e.g. transaction verify
+type command struct {
+	// args: Positional arguments shown in the usage
+	args string
+
+	// summary: One line description shown in the usage
+	summary string
+
+	// flags: Registers the flags of the command and returns the function
+	// that runs it once they are parsed
+	flags func(fs *flag.FlagSet) func(run *runner, args []string) error
+}
+
+// runner holds what a command needs to run
+type runner struct {
+	client *paystack.Client
+	out    *output
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "paystack:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	if len(args) < 1 || args[0] == "help" || args[0] == "-h" || args[0] == "-help" {
+		usage(stderr)
+		return flag.ErrHelp
+	}
+
+	actions, ok := resources[args[0]]
+	if !ok {
+		usage(stderr)
+		return fmt.Errorf("unknown resource %q", args[0])
+	}
+	if len(args) < 2 {
+		resourceUsage(stderr, args[0], actions)
+		return flag.ErrHelp
+	}
+	cmd, ok := actions[args[1]]
+	if !ok {
+		resourceUsage(stderr, args[0], actions)
+		return fmt.Errorf("unknown %s command %q", args[0], args[1])
+	}
+
+	name := args[0] + " " + args[1]
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("o", "json", "output format, json or table")
+	configPath := fs.String("config", "", "config file holding the secret key")
+	exec := cmd.flags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: paystack %s [flags] %s\n\n%s\n\nflags:\n", name, cmd.args, cmd.summary)
+		fs.PrintDefaults()
+	}
+
+	positional, err := parse(fs, args[2:])
+	if err != nil {
+		return err
+	}
+	if *format != "json" && *format != "table" {
+		return fmt.Errorf("unknown output format %q, use json or table", *format)
+	}
+
+	key, err := secretKey(*configPath)
+	if err != nil {
+		return err
+	}
+	client, err := paystack.New(key)
+	if err != nil {
+		return err
+	}
+
+	return exec(&runner{client: client, out: &output{w: stdout, format: *format}}, positional)
+}
+
+// parse parses flags given before, between and after the positional arguments
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// wantArgs checks a command was given exactly the positional arguments it needs
+func wantArgs(args []string, names ...string) error {
+	if len(args) != len(names) {
+		return fmt.Errorf("expected %d argument(s): %s", len(names), strings.Join(names, " "))
+	}
+	return nil
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: paystack <resource> <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "resources:")
+	for _, resource := range sortedKeys(resources) {
+		fmt.Fprintf(w, "  %-13s %s\n", resource, strings.Join(sortedKeys(resources[resource]), ", "))
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run paystack <resource> <command> -h for the flags of a command.")
+}
+
+func resourceUsage(w io.Writer, resource string, actions map[string]command) {
+	fmt.Fprintf(w, "usage: paystack %s <command> [flags] [arguments]\n\ncommands:\n", resource)
+	for _, name := range sortedKeys(actions) {
+		fmt.Fprintf(w, "  %-18s %s\n", strings.TrimSpace(name+" "+actions[name].args), actions[name].summary)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+func TestParse(t *testing.T) {
+	fs := flag.NewFlagSet("transaction fetch", flag.ContinueOnError)
+	format := fs.String("o", "json", "")
+	perPage := fs.Int("per-page", 0, "")
+
+	args, err := parse(fs, []string{"-per-page", "20", "1504248187", "-o", "table"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(args) != 1 || args[0] != "1504248187" || *format != "table" || *perPage != 20 {
+		t.Errorf("unexpected result %v %s %d", args, *format, *perPage)
+	}
+}
+
+func TestSecretKey(t *testing.T) {
+	t.Run("from the environment", func(t *testing.T) {
+		t.Setenv("PAYSTACK_SECRET_KEY", "sk_test_env")
+		if key, err := secretKey(""); err != nil || key != "sk_test_env" {
+			t.Errorf("unexpected key %q %v", key, err)
+		}
+	})
+
+	t.Run("from a config file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.json")
+		if err := os.WriteFile(path, []byte(`{"secret_key":"sk_test_file"}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("PAYSTACK_SECRET_KEY", "")
+		t.Setenv("PAYSTACK_CONFIG", path)
+		if key, err := secretKey(""); err != nil || key != "sk_test_file" {
+			t.Errorf("unexpected key %q %v", key, err)
+		}
+	})
+
+	t.Run("missing config file", func(t *testing.T) {
+		t.Setenv("PAYSTACK_SECRET_KEY", "")
+		if _, err := secretKey(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestRunUsage(t *testing.T) {
+	stderr := &bytes.Buffer{}
+	if err := run([]string{"transaction", "refund"}, &bytes.Buffer{}, stderr); err == nil || !strings.Contains(err.Error(), "refund") {
+		t.Errorf("expected an unknown command error, got %v", err)
+	}
+	if !strings.Contains(stderr.String(), "verify <reference>") {
+		t.Errorf("expected the commands of transaction to be listed, got %s", stderr)
+	}
+}
+
+func TestOutputTable(t *testing.T) {
+	t.Run("list", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		out := &output{w: buf, format: "table"}
+		response := paystack.Response{
+			"status": true,
+			"data": []any{
+				map[string]any{"id": float64(1), "reference": "ref-1", "customer": map[string]any{"email": "customer@email.com"}},
+				map[string]any{"id": float64(2), "reference": "ref-2"},
+			},
+			"meta": map[string]any{"page": float64(1), "pageCount": float64(3), "total": float64(120)},
+		}
+		if err := out.print(response, "id", "reference", "customer.email"); err != nil {
+			t.Fatal(err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if strings.Join(strings.Fields(lines[0]), " ") != "ID REFERENCE CUSTOMER.EMAIL" ||
+			strings.Join(strings.Fields(lines[1]), " ") != "1 ref-1 customer@email.com" ||
+			strings.Join(strings.Fields(lines[2]), " ") != "2 ref-2 -" {
+			t.Errorf("unexpected table\n%s", buf)
+		}
+		if lines[len(lines)-1] != "page 1 of 3, 120 total" {
+			t.Errorf("unexpected pagination %q", lines[len(lines)-1])
+		}
+	})
+
+	t.Run("record", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		out := &output{w: buf, format: "table"}
+		response := paystack.Response{"data": map[string]any{"status": "success", "customer": map[string]any{"email": "customer@email.com"}}}
+		if err := out.print(response); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), "customer.email  customer@email.com") || !strings.Contains(buf.String(), "status          success") {
+			t.Errorf("unexpected record\n%s", buf)
+		}
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSplitUpdate(t *testing.T) {
+	cases := map[string]struct {
+		args   []string
+		bearer any
+	}{
+		"keeps the bearer when not given": {args: []string{"-name", "Halfsies"}, bearer: nil},
+		"sets the bearer when given":      {args: []string{"-bearer-type", "account"}, bearer: "account"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var sent map[string]any
+			client, err := paystack.New("sk_test_xxxx")
+			if err != nil {
+				t.Fatal(err)
+			}
+			client.Config.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				body := `{"status":true,"data":{"id":143,"name":"Percentage Split","active":true,"bearer_type":"subaccount","bearer_subaccount":"ACCT_6uujpqtzmnufzkw"}}`
+				if req.Method == http.MethodPut {
+					if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+						t.Fatal(err)
+					}
+					body = `{"status":true,"data":{"id":143}}`
+				}
+				return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body))}, nil
+			})}
+
+			fs := flag.NewFlagSet("split update", flag.ContinueOnError)
+			exec := resources["split"]["update"].flags(fs)
+			args, err := parse(fs, append(tc.args, "143"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := exec(&runner{client: client, out: &output{w: io.Discard, format: "json"}}, args); err != nil {
+				t.Fatal(err)
+			}
+
+			if sent["bearer_type"] != tc.bearer {
+				t.Errorf("expected bearer_type %v, got %v", tc.bearer, sent["bearer_type"])
+			}
+			if _, ok := sent["bearer_subaccount"]; ok {
+				t.Errorf("expected bearer_subaccount to be left out, got %v", sent)
+			}
+			if sent["name"] == "" || sent["active"] != true {
+				t.Errorf("expected the other fields to be kept, got %v", sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// output prints the responses of commands as JSON or as a table
+type output struct {
+	w      io.Writer
+	format string
+}
+
+// print writes a response. Tables show the columns given for a list, or
+// every field of a single record.
+func (o *output) print(response any, columns ...string) error {
+	if o.format == "json" {
+		encoder := json.NewEncoder(o.w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(response)
+	}
+
+	generic, err := toGeneric(response)
+	if err != nil {
+		return err
+	}
+	object, ok := generic.(map[string]any)
+	if !ok {
+		_, err := fmt.Fprintln(o.w, format(generic))
+		return err
+	}
+
+	data, hasData := object["data"]
+	if !hasData {
+		data = object
+	}
+	switch data := data.(type) {
+	case []any:
+		if err := o.table(data, columns); err != nil {
+			return err
+		}
+		if meta, ok := object["meta"].(map[string]any); ok {
+			return o.pagination(meta)
+		}
+		return nil
+	case map[string]any:
+		return o.record(data)
+	default:
+		_, err := fmt.Fprintln(o.w, format(data))
+		return err
+	}
+}
+
+// table writes a row per record
+func (o *output) table(rows []any, columns []string) error {
+	if len(columns) == 0 && len(rows) > 0 {
+		if first, ok := rows[0].(map[string]any); ok {
+			columns = scalarKeys(first)
+		}
+	}
+
+	tw := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, row := range rows {
+		record, _ := row.(map[string]any)
+		values := make([]string, len(columns))
+		for i, column := range columns {
+			values[i] = format(lookup(record, column))
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+// record writes a field per line, nested objects flattened to dotted names
+func (o *output) record(record map[string]any) error {
+	fields := map[string]any{}
+	flatten("", record, fields)
+
+	tw := tabwriter.NewWriter(o.w, 0, 4, 2, ' ', 0)
+	for _, key := range sortedKeys(fields) {
+		fmt.Fprintf(tw, "%s\t%s\n", key, format(fields[key]))
+	}
+	return tw.Flush()
+}
+
+func (o *output) pagination(meta map[string]any) error {
+	if next, _ := meta["next"].(string); next != "" {
+		_, err := fmt.Fprintf(o.w, "\nnext: %s\n", next)
+		return err
+	}
+	if meta["page"] == nil {
+		return nil
+	}
+	_, err := fmt.Fprintf(o.w, "\npage %s of %s, %s total\n", format(meta["page"]), format(meta["pageCount"]), format(meta["total"]))
+	return err
+}
+
+// toGeneric converts a typed response to maps and slices
+func toGeneric(response any) (any, error) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+// lookup returns a field of record, following dots into nested objects e.g. customer.email
+func lookup(record map[string]any, path string) any {
+	var value any = record
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+func flatten(prefix string, value any, fields map[string]any) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		fields[prefix] = value
+		return
+	}
+	for key, inner := range object {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		flatten(key, inner, fields)
+	}
+}
+
+// scalarKeys returns the sorted keys of the fields of record that fit in a column
+func scalarKeys(record map[string]any) []string {
+	var keys []string
+	for key, value := range record {
+		switch value.(type) {
+		case map[string]any, []any:
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func format(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
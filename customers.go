@@ -3,7 +3,6 @@
 package paystack
 
 import (
-	"encoding/json"
 	"fmt"
 )
 
@@ -22,7 +21,7 @@ type CreateCustomerBody struct {
 
 	// Metadata: A set of key/value pairs that you can attach to the customer.
 	//It can be used to store additional information in a structured format.
-	Metadata map[string]any `json:"metadata,omitempty"`
+	Metadata *Metadata `json:"metadata,omitempty"`
 }
 
 type UpdateCustomerBody struct {
@@ -37,7 +36,7 @@ type UpdateCustomerBody struct {
 
 	// Metadata: A set of key/value pairs that you can attach to the customer.
 	//It can be used to store additional information in a structured format.
-	Metadata map[string]any `json:"metadata,omitempty"`
+	Metadata *Metadata `json:"metadata,omitempty"`
 }
 
 type ValidateCustomerBody struct {
@@ -73,13 +72,54 @@ type ValidateCustomerBody struct {
 	MiddleName string `json:"middle_name,omitempty"`
 }
 
+// Validate checks the customer has a valid email address
+func (b *CreateCustomerBody) Validate() error {
+	v := &validation{}
+	v.email("email", b.Email, true)
+	return v.err()
+}
+
+// Validate checks the identification details before they are sent
+func (b *ValidateCustomerBody) Validate() error {
+	v := &validation{}
+	v.required("first_name", b.FirstName)
+	v.required("last_name", b.LastName)
+	v.required("type", b.Type)
+	v.required("value", b.Value)
+	if len(b.Country) != 2 {
+		v.add("country", "must be a 2 letter country code")
+	}
+	if b.BVN != "" {
+		v.digits("bvn", b.BVN, 11)
+	}
+	if b.Type == "bank_account" {
+		v.required("bank_code", b.BankCode)
+		if b.AccountNumber == "" {
+			v.add("account_number", "is required")
+		} else {
+			v.digits("account_number", b.AccountNumber, 0)
+		}
+	}
+	return v.err()
+}
+
 type WhiteListOrBlacklistCustomerBody struct {
 	// Customer's code, or email address
 	Customer string `json:"customer"`
 
 	// One of the possible risk actions [ default, allow, deny ].
 	// allow to whitelist. deny to blacklist. Customers start with a default risk action.
-	RiskAction string `json:"risk_action"`
+	RiskAction RiskAction `json:"risk_action"`
+}
+
+// Validate checks the customer and risk action
+func (b *WhiteListOrBlacklistCustomerBody) Validate() error {
+	v := &validation{}
+	v.required("customer", b.Customer)
+	if b.RiskAction != "" && !b.RiskAction.Valid() {
+		v.add("risk_action", "must be one of default, allow or deny, got %q", b.RiskAction)
+	}
+	return v.err()
 }
 
 type DeactivateAuthorizationBody struct {
@@ -87,7 +127,14 @@ type DeactivateAuthorizationBody struct {
 	AuthorizationCode string `json:"authorization_code"`
 }
 
-// CreateCustomer create a customer on your integration
+// Validate checks the authorization code is set
+func (b *DeactivateAuthorizationBody) Validate() error {
+	v := &validation{}
+	v.required("authorization_code", b.AuthorizationCode)
+	return v.err()
+}
+
+// Create create a customer on your integration
 //
 // **Customer Validation**
 //
@@ -99,124 +146,85 @@ type DeactivateAuthorizationBody struct {
 //
 // Docs: https://paystack.com/docs/api/#customer-create
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.CreateCustomer(body struct{}})
-func (c *Config) CreateCustomer(body *CreateCustomerBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Customers.Create(body struct{}})
+func (s *Customers) Create(body *CreateCustomerBody) (Response, error) {
 	path := "/customer"
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
-// ListCustomers lists customers available on your integration
+// List lists customers available on your integration
 //
 // Docs: https://paystack.com/docs/api/#customer-list
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.ListCustomers()
-func (c *Config) ListCustomers() (Response, error) {
-	path := "/customer"
-
-	response, err := c.makeRequest("GET", path, nil)
+//	client, _ := paystack.New(apiKey)
+//	customers, err := client.Customers.List(&paystack.ListParams{PerPage: 20, Page: 2})
+func (s *Customers) List(params ...*ListParams) (Response, error) {
+	path, err := listPath("/customer", params)
 	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
+		return nil, err
 	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+
+	return s.config.call("GET", path, nil)
 }
 
-// FetchCustomer gets details of a customer on your integration
+// Fetch gets details of a customer on your integration
 //
 // Docs: https://paystack.com/docs/api/#customer-fetch
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.FetchCustomer(emailOrCode string)
-func (c *Config) FetchCustomer(emailOrCode string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Customers.Fetch(emailOrCode string)
+func (s *Customers) Fetch(emailOrCode string) (Response, error) {
 	path := fmt.Sprintf("/customer/%s", emailOrCode)
 
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
-// UpdateCustomer updates a customer's detail on your integration
+// Update updates a customer's detail on your integration
 //
 // Docs: https://paystack.com/docs/api/#customer-update
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.UpdateCustomer(code string, body structs{})
-func (c *Config) UpdateCustomer(code string, body *UpdateCustomerBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Customers.Update(code string, body structs{})
+func (s *Customers) Update(code string, body *UpdateCustomerBody) (Response, error) {
 	path := fmt.Sprintf("/customer/%s", code)
 
-	response, err := c.makeRequest("PUT", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("PUT", path, body)
 }
 
-// ValidateCustomer validates a customer's identity
+// Validate validates a customer's identity
 //
 // Docs: https://paystack.com/docs/api/#customer-validate
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.ValidateCustomer(code string, body structs{})
-func (c *Config) ValidateCustomer(code string, body *ValidateCustomerBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Customers.Validate(code string, body structs{})
+func (s *Customers) Validate(code string, body *ValidateCustomerBody) (Response, error) {
 	path := fmt.Sprintf("/customer/%s/identification", code)
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
-// WhiteListOrBlacklistCustomer: Whitelist or blacklist a customer on your integration
+// SetRiskAction: Whitelist or blacklist a customer on your integration
 //
 // Docs: https://paystack.com/docs/api/#customer-whitelist-blacklist
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.WhiteListOrBlacklistCustomer(body structs{})
-func (c *Config) WhiteListOrBlacklistCustomer(body *WhiteListOrBlacklistCustomerBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Customers.SetRiskAction(body structs{})
+func (s *Customers) SetRiskAction(body *WhiteListOrBlacklistCustomerBody) (Response, error) {
 	path := "/customer/set_risk_action"
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
 // DeactivateAuthorization: Deactivate an authorization when the card needs to be forgotten
 //
 // Docs: https://paystack.com/docs/api/#customer-deactivate-authorization
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.DeactivateAuthorization(body structs{})
-func (c *Config) DeactivateAuthorization(body *DeactivateAuthorizationBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Customers.DeactivateAuthorization(body structs{})
+func (s *Customers) DeactivateAuthorization(body *DeactivateAuthorizationBody) (Response, error) {
 	path := "/customer/deactivate_authorization"
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
@@ -1,8 +1,6 @@
 package paystack
 
 import (
-	"encoding/json"
-	"fmt"
 	"testing"
 )
 
@@ -15,55 +13,43 @@ func TestCreateCustomer(t *testing.T) {
 	}
 
 	t.Run("create customers", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
-
-		if createCustomer.Email == "" {
-			t.Error("please provide an email")
-		}
+		client := newRecordedClient(t, "create_customer")
 
 		response, err := client.CreateCustomer(createCustomer)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+		requireFields(t, responseData(t, response), "customer_code", "email", "id")
 	})
 }
 
 func TestListCustomers(t *testing.T) {
 	t.Run("list customers", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "list_customers")
 
 		response, err := client.ListCustomers()
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
+		}
+
+		if _, ok := response["data"].([]any); response["status"] != true || !ok {
+			t.Errorf("unexpected response %v", response)
 		}
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
 	})
 }
 
 func TestFetchCustomer(t *testing.T) {
 	t.Run("fetch customers", func(t *testing.T) {
 		customerEmail := "test@test.com"
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "fetch_customer")
 
 		response, err := client.FetchCustomer(customerEmail)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+
+		requireFields(t, responseData(t, response), "customer_code", "email", "authorizations")
 	})
 }
 
@@ -75,17 +61,17 @@ func TestUpdateCustomer(t *testing.T) {
 
 	t.Run("update customer", func(t *testing.T) {
 		code := "CUS_42qtajqgknfkqgy"
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "update_customer")
 
 		response, err := client.UpdateCustomer(code, updateCustomer)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
+		}
+
+		data := responseData(t, response)
+		if data["customer_code"] != code {
+			t.Errorf("unexpected customer %v", data)
 		}
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
 	})
 }
 
@@ -98,21 +84,21 @@ func TestValidateCustomer(t *testing.T) {
 		BankCode:      "007",
 		Country:       "NG",
 		Type:          "bank_account",
+		Value:         "0123456789",
 	}
 
 	t.Run("validate customers", func(t *testing.T) {
 		code := "CUS_42qtajqgknfkqgy"
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "validate_customer")
 
 		response, err := client.ValidateCustomer(code, validateCustomer)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
+		}
+
+		if response["status"] != true {
+			t.Errorf("unexpected response %v", response)
 		}
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
 	})
 }
 
@@ -122,21 +108,17 @@ func TestWhiteListOrBlacklistCustomer(t *testing.T) {
 		RiskAction: "allow",
 	}
 	t.Run("whitelist or blacklist customers", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
-
-		if testCase.Customer == "" {
-			t.Error("please provide an email")
-		}
+		client := newRecordedClient(t, "set_risk_action")
 
 		response, err := client.WhiteListOrBlacklistCustomer(testCase)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
+		}
+
+		data := responseData(t, response)
+		if data["risk_action"] != "allow" {
+			t.Errorf("unexpected customer %v", data)
 		}
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
 	})
 }
 
@@ -146,19 +128,15 @@ func TestDeactivateAuthorization(t *testing.T) {
 	}
 
 	t.Run("deactivate authorization", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
-		if deactivate.AuthorizationCode == "" {
-			t.Error("please provide an authorization code")
-		}
+		client := newRecordedClient(t, "deactivate_authorization")
 
 		response, err := client.DeactivateAuthorization(deactivate)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
+		}
+
+		if response["status"] != true {
+			t.Errorf("unexpected response %v", response)
 		}
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
 	})
 }
@@ -0,0 +1,346 @@
+package paystack
+
+import "context"
+
+// InitializeTransaction is Transactions.Initialize
+//
+// Deprecated: Use Client.Transactions.Initialize instead.
+func (c *Config) InitializeTransaction(body *TransactionBody) (Response, error) {
+	return (&Transactions{config: c}).Initialize(body)
+}
+
+// VerifyTransaction is Transactions.Verify
+//
+// Deprecated: Use Client.Transactions.Verify instead.
+func (c *Config) VerifyTransaction(reference string) (Response, error) {
+	return (&Transactions{config: c}).Verify(reference)
+}
+
+// ListTransactions is Transactions.List
+//
+// Deprecated: Use Client.Transactions.List instead.
+func (c *Config) ListTransactions(params ...*ListParams) (Response, error) {
+	return (&Transactions{config: c}).List(params...)
+}
+
+// ListTransactionsPage is Transactions.ListPage
+//
+// Deprecated: Use Client.Transactions.ListPage instead.
+func (c *Config) ListTransactionsPage(params *ListTransactionsParams) (*ListResponse[Transaction], error) {
+	return (&Transactions{config: c}).ListPage(params)
+}
+
+// FetchTransaction is Transactions.Fetch
+//
+// Deprecated: Use Client.Transactions.Fetch instead.
+func (c *Config) FetchTransaction(transactionID uint64) (Response, error) {
+	return (&Transactions{config: c}).Fetch(transactionID)
+}
+
+// ChargeAuthorization is Transactions.ChargeAuthorization
+//
+// Deprecated: Use Client.Transactions.ChargeAuthorization instead.
+func (c *Config) ChargeAuthorization(body *ChargeAuthorizationBody) (Response, error) {
+	return (&Transactions{config: c}).ChargeAuthorization(body)
+}
+
+// CheckAuthorization is Transactions.CheckAuthorization
+//
+// Deprecated: Use Client.Transactions.CheckAuthorization instead.
+func (c *Config) CheckAuthorization(body *CheckAuthorizationBody) (Response, error) {
+	return (&Transactions{config: c}).CheckAuthorization(body)
+}
+
+// ViewTransactionTimeLine is Transactions.Timeline
+//
+// Deprecated: Use Client.Transactions.Timeline instead.
+func (c *Config) ViewTransactionTimeLine(referenceOrID string) (Response, error) {
+	return (&Transactions{config: c}).Timeline(referenceOrID)
+}
+
+// TransactionTotals is Transactions.Totals
+//
+// Deprecated: Use Client.Transactions.Totals instead.
+func (c *Config) TransactionTotals() (Response, error) {
+	return (&Transactions{config: c}).Totals()
+}
+
+// ExportTransactions is Transactions.Export
+//
+// Deprecated: Use Client.Transactions.Export instead.
+func (c *Config) ExportTransactions() (Response, error) {
+	return (&Transactions{config: c}).Export()
+}
+
+// PartialDebit is Transactions.PartialDebit
+//
+// Deprecated: Use Client.Transactions.PartialDebit instead.
+func (c *Config) PartialDebit(body *PartialDebitBody) (Response, error) {
+	return (&Transactions{config: c}).PartialDebit(body)
+}
+
+// InitializeTransactionOnce is Transactions.InitializeOnce
+//
+// Deprecated: Use Client.Transactions.InitializeOnce instead.
+func (c *Config) InitializeTransactionOnce(body *TransactionBody) (Response, error) {
+	return (&Transactions{config: c}).InitializeOnce(body)
+}
+
+// ChargeAuthorizationOnce is Transactions.ChargeAuthorizationOnce
+//
+// Deprecated: Use Client.Transactions.ChargeAuthorizationOnce instead.
+func (c *Config) ChargeAuthorizationOnce(body *ChargeAuthorizationBody) (Response, error) {
+	return (&Transactions{config: c}).ChargeAuthorizationOnce(body)
+}
+
+// WaitForTransaction is Transactions.Wait
+//
+// Deprecated: Use Client.Transactions.Wait instead.
+func (c *Config) WaitForTransaction(ctx context.Context, reference string, opts *WaitOptions) (*Transaction, error) {
+	return (&Transactions{config: c}).Wait(ctx, reference, opts)
+}
+
+// ExportTransactionsFile is Transactions.ExportFile
+//
+// Deprecated: Use Client.Transactions.ExportFile instead.
+func (c *Config) ExportTransactionsFile(params *ExportTransactionsParams) (*DataResponse[TransactionExport], error) {
+	return (&Transactions{config: c}).ExportFile(params)
+}
+
+// ExportTransactionRows is Transactions.ExportRows
+//
+// Deprecated: Use Client.Transactions.ExportRows instead.
+func (c *Config) ExportTransactionRows(params *ExportTransactionsParams) (*ExportReader, error) {
+	return (&Transactions{config: c}).ExportRows(params)
+}
+
+// CreateCustomer is Customers.Create
+//
+// Deprecated: Use Client.Customers.Create instead.
+func (c *Config) CreateCustomer(body *CreateCustomerBody) (Response, error) {
+	return (&Customers{config: c}).Create(body)
+}
+
+// ListCustomers is Customers.List
+//
+// Deprecated: Use Client.Customers.List instead.
+func (c *Config) ListCustomers(params ...*ListParams) (Response, error) {
+	return (&Customers{config: c}).List(params...)
+}
+
+// FetchCustomer is Customers.Fetch
+//
+// Deprecated: Use Client.Customers.Fetch instead.
+func (c *Config) FetchCustomer(emailOrCode string) (Response, error) {
+	return (&Customers{config: c}).Fetch(emailOrCode)
+}
+
+// UpdateCustomer is Customers.Update
+//
+// Deprecated: Use Client.Customers.Update instead.
+func (c *Config) UpdateCustomer(code string, body *UpdateCustomerBody) (Response, error) {
+	return (&Customers{config: c}).Update(code, body)
+}
+
+// ValidateCustomer is Customers.Validate
+//
+// Deprecated: Use Client.Customers.Validate instead.
+func (c *Config) ValidateCustomer(code string, body *ValidateCustomerBody) (Response, error) {
+	return (&Customers{config: c}).Validate(code, body)
+}
+
+// WhiteListOrBlacklistCustomer is Customers.SetRiskAction
+//
+// Deprecated: Use Client.Customers.SetRiskAction instead.
+func (c *Config) WhiteListOrBlacklistCustomer(body *WhiteListOrBlacklistCustomerBody) (Response, error) {
+	return (&Customers{config: c}).SetRiskAction(body)
+}
+
+// DeactivateAuthorization is Customers.DeactivateAuthorization
+//
+// Deprecated: Use Client.Customers.DeactivateAuthorization instead.
+func (c *Config) DeactivateAuthorization(body *DeactivateAuthorizationBody) (Response, error) {
+	return (&Customers{config: c}).DeactivateAuthorization(body)
+}
+
+// CreatePlan is Plans.Create
+//
+// Deprecated: Use Client.Plans.Create instead.
+func (c *Config) CreatePlan(body *Plan) (Response, error) {
+	return (&Plans{config: c}).Create(body)
+}
+
+// ListPlans is Plans.List
+//
+// Deprecated: Use Client.Plans.List instead.
+func (c *Config) ListPlans(params ...*ListParams) (Response, error) {
+	return (&Plans{config: c}).List(params...)
+}
+
+// FetchPlan is Plans.Fetch
+//
+// Deprecated: Use Client.Plans.Fetch instead.
+func (c *Config) FetchPlan(codeOrID string) (Response, error) {
+	return (&Plans{config: c}).Fetch(codeOrID)
+}
+
+// UpdatePlan is Plans.Update
+//
+// Deprecated: Use Client.Plans.Update instead.
+func (c *Config) UpdatePlan(codeOrID string, body *Plan) (Response, error) {
+	return (&Plans{config: c}).Update(codeOrID, body)
+}
+
+// CreateSubscription is Subscriptions.Create
+//
+// Deprecated: Use Client.Subscriptions.Create instead.
+func (c *Config) CreateSubscription(body *CreateSubscriptionBody) (Response, error) {
+	return (&Subscriptions{config: c}).Create(body)
+}
+
+// ListSubscriptions is Subscriptions.List
+//
+// Deprecated: Use Client.Subscriptions.List instead.
+func (c *Config) ListSubscriptions(params ...*ListParams) (Response, error) {
+	return (&Subscriptions{config: c}).List(params...)
+}
+
+// FetchSubscription is Subscriptions.Fetch
+//
+// Deprecated: Use Client.Subscriptions.Fetch instead.
+func (c *Config) FetchSubscription(codeOrID string) (Response, error) {
+	return (&Subscriptions{config: c}).Fetch(codeOrID)
+}
+
+// SubscriptionEmailToken is Subscriptions.EmailToken
+//
+// Deprecated: Use Client.Subscriptions.EmailToken instead.
+func (c *Config) SubscriptionEmailToken(codeOrID string) (string, error) {
+	return (&Subscriptions{config: c}).EmailToken(codeOrID)
+}
+
+// EnableSubscriptionByCode is Subscriptions.EnableByCode
+//
+// Deprecated: Use Client.Subscriptions.EnableByCode instead.
+func (c *Config) EnableSubscriptionByCode(code string) (Response, error) {
+	return (&Subscriptions{config: c}).EnableByCode(code)
+}
+
+// DisableSubscriptionByCode is Subscriptions.DisableByCode
+//
+// Deprecated: Use Client.Subscriptions.DisableByCode instead.
+func (c *Config) DisableSubscriptionByCode(code string) (Response, error) {
+	return (&Subscriptions{config: c}).DisableByCode(code)
+}
+
+// EnableSubscription is Subscriptions.Enable
+//
+// Deprecated: Use Client.Subscriptions.Enable instead.
+func (c *Config) EnableSubscription(body *SubscriptionBody) (Response, error) {
+	return (&Subscriptions{config: c}).Enable(body)
+}
+
+// DisableSubscription is Subscriptions.Disable
+//
+// Deprecated: Use Client.Subscriptions.Disable instead.
+func (c *Config) DisableSubscription(body *SubscriptionBody) (Response, error) {
+	return (&Subscriptions{config: c}).Disable(body)
+}
+
+// GenerateUpdateSubscriptionLink is Subscriptions.GenerateUpdateLink
+//
+// Deprecated: Use Client.Subscriptions.GenerateUpdateLink instead.
+func (c *Config) GenerateUpdateSubscriptionLink(code string) (Response, error) {
+	return (&Subscriptions{config: c}).GenerateUpdateLink(code)
+}
+
+// SendUpdateSubscriptionLink is Subscriptions.SendUpdateLink
+//
+// Deprecated: Use Client.Subscriptions.SendUpdateLink instead.
+func (c *Config) SendUpdateSubscriptionLink(code string) (Response, error) {
+	return (&Subscriptions{config: c}).SendUpdateLink(code)
+}
+
+// CreateSplit is Splits.Create
+//
+// Deprecated: Use Client.Splits.Create instead.
+func (c *Config) CreateSplit(body *CreateSplitBody) (Response, error) {
+	return (&Splits{config: c}).Create(body)
+}
+
+// ListAndSearchSplits is Splits.List
+//
+// Deprecated: Use Client.Splits.List instead.
+func (c *Config) ListAndSearchSplits(params ...*ListParams) (Response, error) {
+	return (&Splits{config: c}).List(params...)
+}
+
+// FetchSplit is Splits.Fetch
+//
+// Deprecated: Use Client.Splits.Fetch instead.
+func (c *Config) FetchSplit(id string) (Response, error) {
+	return (&Splits{config: c}).Fetch(id)
+}
+
+// UpdateSplit is Splits.Update
+//
+// Deprecated: Use Client.Splits.Update instead.
+func (c *Config) UpdateSplit(body *UpdateSplitBody, id string) (Response, error) {
+	return (&Splits{config: c}).Update(id, body)
+}
+
+// AddAndUpdateSplitSubaccount is Splits.AddSubaccount
+//
+// Deprecated: Use Client.Splits.AddSubaccount instead.
+func (c *Config) AddAndUpdateSplitSubaccount(body *AddAndUpdateSplitSubaccountBody, id string) (Response, error) {
+	return (&Splits{config: c}).AddSubaccount(id, body)
+}
+
+// RemoveSubAccountFromSplit is Splits.RemoveSubaccount
+//
+// Deprecated: Use Client.Splits.RemoveSubaccount instead.
+func (c *Config) RemoveSubAccountFromSplit(body *RemoveSubAccountFromSplitBody, id string) (Response, error) {
+	return (&Splits{config: c}).RemoveSubaccount(id, body)
+}
+
+// ListBanks is Misc.ListBanks
+//
+// Deprecated: Use Client.Misc.ListBanks instead.
+func (c *Config) ListBanks(params *ListBanksParams) (*ListResponse[Bank], error) {
+	return (&Misc{config: c}).ListBanks(params)
+}
+
+// ListCountries is Misc.ListCountries
+//
+// Deprecated: Use Client.Misc.ListCountries instead.
+func (c *Config) ListCountries() (*ListResponse[Country], error) {
+	return (&Misc{config: c}).ListCountries()
+}
+
+// ListStates is Misc.ListStates
+//
+// Deprecated: Use Client.Misc.ListStates instead.
+func (c *Config) ListStates(country string) (*ListResponse[State], error) {
+	return (&Misc{config: c}).ListStates(country)
+}
+
+// ResolveAccountNumber is Verification.ResolveAccountNumber
+//
+// Deprecated: Use Client.Verification.ResolveAccountNumber instead.
+func (c *Config) ResolveAccountNumber(accountNumber, bankCode string) (*DataResponse[ResolvedAccount], error) {
+	return (&Verification{config: c}).ResolveAccountNumber(accountNumber, bankCode)
+}
+
+// ValidateAccount is Verification.ValidateAccount
+//
+// Deprecated: Use Client.Verification.ValidateAccount instead.
+func (c *Config) ValidateAccount(body *ValidateAccountBody) (*DataResponse[ValidatedAccount], error) {
+	return (&Verification{config: c}).ValidateAccount(body)
+}
+
+// ResolveCardBIN is Verification.ResolveCardBIN
+//
+// Deprecated: Use Client.Verification.ResolveCardBIN instead.
+func (c *Config) ResolveCardBIN(bin string) (*DataResponse[CardBIN], error) {
+	return (&Verification{config: c}).ResolveCardBIN(bin)
+}
@@ -0,0 +1,64 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Do calls any endpoint of the Paystack API, including ones the SDK has
+// no method for yet. The call is made like every other: with the key,
+// middleware, logging, rate limits, retries of throttled calls and circuit
+// breaker of the client, and a non-2xx response is returned as an *APIError.
+//
+// path is relative to the API, e.g. /dedicated_account, and query is
+// appended to it. body is sent as JSON when it is not nil and validated
+// first when it implements Validator. The response is unmarshalled into
+// out unless it is nil.
+//
+// Docs: https://paystack.com/docs/api
+//
+//	client, _ := paystack.New(apiKey)
+//	accounts := &paystack.ListResponse[DedicatedAccount]{}
+//	err := client.Do(ctx, "GET", "/dedicated_account", url.Values{"active": {"true"}}, nil, accounts)
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	return c.Config.Do(ctx, method, path, query, body, out)
+}
+
+// Do calls any endpoint of the Paystack API, see Client.Do
+func (c *Config) Do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	path, err := endpointPath(path, query)
+	if err != nil {
+		return err
+	}
+
+	if ctx != nil {
+		c = c.WithContext(ctx)
+	}
+	return c.decode(method, path, body, out)
+}
+
+// endpointPath appends query to path, which must be relative to the API
+// so the secret key is never sent to another host
+func endpointPath(path string, query url.Values) (string, error) {
+	parsed, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	if parsed.Scheme != "" || parsed.Host != "" {
+		return "", errors.New("path must be relative to the Paystack API, e.g. /transaction")
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if len(query) == 0 {
+		return path, nil
+	}
+	if strings.Contains(path, "?") {
+		return path + "&" + query.Encode(), nil
+	}
+	return withQuery(path, query), nil
+}
@@ -0,0 +1,124 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestDo(t *testing.T) {
+	type dedicatedAccount struct {
+		ID            int    `json:"id"`
+		AccountNumber string `json:"account_number"`
+	}
+
+	t.Run("sends the call and decodes the response", func(t *testing.T) {
+		var method, uri, auth string
+		var body map[string]any
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			method, uri, auth = r.Method, r.URL.RequestURI(), r.Header.Get("Authorization")
+			body = nil
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = w.Write([]byte(`{"status":true,"data":{"id":253,"account_number":"9930000737"}}`))
+		}))
+
+		account := &DataResponse[dedicatedAccount]{}
+		err := client.Do(context.Background(), "POST", "/dedicated_account", url.Values{"preferred_bank": {"wema-bank"}}, map[string]any{"customer": 481193}, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if method != "POST" || uri != "/dedicated_account?preferred_bank=wema-bank" {
+			t.Errorf("sent %s %s", method, uri)
+		}
+		if auth != "Bearer sk_test_xxxx" {
+			t.Errorf("sent authorization %q", auth)
+		}
+		if body["customer"] != float64(481193) {
+			t.Errorf("sent body %v", body)
+		}
+		if account.Data.ID != 253 || account.Data.AccountNumber != "9930000737" {
+			t.Errorf("got %+v", account.Data)
+		}
+	})
+
+	t.Run("runs the middleware", func(t *testing.T) {
+		config := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":true}`))
+		})
+		var path string
+		config.Use(func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Result, error) {
+				path = req.Path
+				return next(ctx, req)
+			}
+		})
+
+		if err := config.Do(context.Background(), "GET", "bank/resolve", url.Values{"account_number": {"0022728151"}}, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		if path != "/bank/resolve?account_number=0022728151" {
+			t.Errorf("middleware got path %s", path)
+		}
+	})
+
+	t.Run("returns api errors", func(t *testing.T) {
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Customer is required"}`))
+		}))
+
+		var apiErr *APIError
+		err := client.Do(context.Background(), "POST", "/dedicated_account", nil, map[string]any{}, &json.RawMessage{})
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "Customer is required" {
+			t.Errorf("got error %v", err)
+		}
+	})
+
+	t.Run("uses the context", func(t *testing.T) {
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":true}`))
+		}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := client.Do(ctx, "GET", "/balance", nil, nil, nil); !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("rejects absolute urls", func(t *testing.T) {
+		called := false
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+
+		for _, path := range []string{"https://example.com/collect", "//example.com/collect"} {
+			if err := client.Do(context.Background(), "GET", path, nil, nil, nil); err == nil {
+				t.Errorf("expected an error for %s", path)
+			}
+		}
+		if called {
+			t.Error("the call was sent")
+		}
+	})
+
+	t.Run("appends the query to a path with one", func(t *testing.T) {
+		var query string
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.RawQuery
+			_, _ = io.Copy(io.Discard, r.Body)
+			_, _ = w.Write([]byte(`{"status":true}`))
+		}))
+
+		if err := client.Do(context.Background(), "GET", "/transaction?status=success", url.Values{"perPage": {"10"}}, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		if query != "status=success&perPage=10" {
+			t.Errorf("sent query %s", query)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package paystack
+
+// Channel is a payment channel a customer can pay with
+type Channel string
+
+const (
+	ChannelCard         Channel = "card"
+	ChannelBank         Channel = "bank"
+	ChannelUSSD         Channel = "ussd"
+	ChannelQR           Channel = "qr"
+	ChannelMobileMoney  Channel = "mobile_money"
+	ChannelBankTransfer Channel = "bank_transfer"
+	ChannelEFT          Channel = "eft"
+)
+
+// Valid reports whether ch is a channel Paystack accepts
+func (ch Channel) Valid() bool {
+	switch ch {
+	case ChannelCard, ChannelBank, ChannelUSSD, ChannelQR, ChannelMobileMoney, ChannelBankTransfer, ChannelEFT:
+		return true
+	}
+	return false
+}
+
+// Currency is an ISO 4217 currency code supported by Paystack
+type Currency string
+
+const (
+	CurrencyNGN Currency = "NGN"
+	CurrencyGHS Currency = "GHS"
+	CurrencyZAR Currency = "ZAR"
+	CurrencyKES Currency = "KES"
+	CurrencyUSD Currency = "USD"
+)
+
+// Valid reports whether cur is a currency Paystack accepts
+func (cur Currency) Valid() bool {
+	switch cur {
+	case CurrencyNGN, CurrencyGHS, CurrencyZAR, CurrencyKES, CurrencyUSD:
+		return true
+	}
+	return false
+}
+
+// Interval is how often a plan charges its subscribers
+type Interval string
+
+const (
+	IntervalHourly     Interval = "hourly"
+	IntervalDaily      Interval = "daily"
+	IntervalWeekly     Interval = "weekly"
+	IntervalMonthly    Interval = "monthly"
+	IntervalQuarterly  Interval = "quarterly"
+	IntervalBiannually Interval = "biannually"
+	IntervalAnnually   Interval = "annually"
+)
+
+// Valid reports whether i is an interval Paystack accepts
+func (i Interval) Valid() bool {
+	switch i {
+	case IntervalHourly, IntervalDaily, IntervalWeekly, IntervalMonthly, IntervalQuarterly, IntervalBiannually, IntervalAnnually:
+		return true
+	}
+	return false
+}
+
+// SplitType is how the shares of a transaction split are expressed
+type SplitType string
+
+const (
+	SplitTypePercentage SplitType = "percentage"
+	SplitTypeFlat       SplitType = "flat"
+)
+
+// Valid reports whether t is a split type Paystack accepts
+func (t SplitType) Valid() bool {
+	return t == SplitTypePercentage || t == SplitTypeFlat
+}
+
+// BearerType is who bears the Paystack charges on a transaction
+type BearerType string
+
+const (
+	BearerAccount         BearerType = "account"
+	BearerSubaccount      BearerType = "subaccount"
+	BearerAllProportional BearerType = "all-proportional"
+	BearerAll             BearerType = "all"
+)
+
+// Valid reports whether b is a bearer type Paystack accepts on a split
+func (b BearerType) Valid() bool {
+	switch b {
+	case BearerAccount, BearerSubaccount, BearerAllProportional, BearerAll:
+		return true
+	}
+	return false
+}
+
+// validTransactionBearer reports whether b can bear the charges of a
+// single transaction, which only takes account or subaccount
+func (b BearerType) validTransactionBearer() bool {
+	return b == BearerAccount || b == BearerSubaccount
+}
+
+// RiskAction is the risk action set on a customer
+type RiskAction string
+
+const (
+	RiskActionDefault RiskAction = "default"
+	RiskActionAllow   RiskAction = "allow"
+	RiskActionDeny    RiskAction = "deny"
+)
+
+// Valid reports whether r is a risk action Paystack accepts
+func (r RiskAction) Valid() bool {
+	return r == RiskActionDefault || r == RiskActionAllow || r == RiskActionDeny
+}
+
+// TransactionStatus is the status of a transaction
+type TransactionStatus string
+
+const (
+	TransactionSuccess    TransactionStatus = "success"
+	TransactionFailed     TransactionStatus = "failed"
+	TransactionAbandoned  TransactionStatus = "abandoned"
+	TransactionReversed   TransactionStatus = "reversed"
+	TransactionOngoing    TransactionStatus = "ongoing"
+	TransactionPending    TransactionStatus = "pending"
+	TransactionProcessing TransactionStatus = "processing"
+	TransactionQueued     TransactionStatus = "queued"
+)
+
+// Final reports whether a transaction with status s will not change status again
+func (s TransactionStatus) Final() bool {
+	switch s {
+	case TransactionSuccess, TransactionFailed, TransactionAbandoned, TransactionReversed:
+		return true
+	}
+	return false
+}
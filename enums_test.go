@@ -0,0 +1,57 @@
+package paystack
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestEnumsValid(t *testing.T) {
+	t.Run("known values", func(t *testing.T) {
+		if !ChannelMobileMoney.Valid() || !CurrencyKES.Valid() || !IntervalBiannually.Valid() ||
+			!SplitTypeFlat.Valid() || !BearerAllProportional.Valid() || !RiskActionDeny.Valid() {
+			t.Error("expected the exported constants to be valid")
+		}
+	})
+
+	t.Run("unknown values", func(t *testing.T) {
+		if Channel("cards").Valid() || Currency("ngn").Valid() || Interval("monthy").Valid() ||
+			SplitType("percent").Valid() || BearerType("all_proportional").Valid() || RiskAction("block").Valid() {
+			t.Error("expected typos to be invalid")
+		}
+	})
+}
+
+func TestTransactionStatusFinal(t *testing.T) {
+	for _, status := range []TransactionStatus{TransactionSuccess, TransactionFailed, TransactionAbandoned, TransactionReversed} {
+		if !status.Final() {
+			t.Errorf("expected %s to be final", status)
+		}
+	}
+	for _, status := range []TransactionStatus{TransactionOngoing, TransactionPending, TransactionProcessing, TransactionQueued} {
+		if status.Final() {
+			t.Errorf("expected %s not to be final", status)
+		}
+	}
+}
+
+func TestEnumsValidatedBeforeRequest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	if _, err := client.InitializeTransaction(&TransactionBody{Amount: "300", Email: "test@test.com", Channels: []Channel{ChannelCard, "cash"}}); err == nil {
+		t.Error("expected an invalid channel to be rejected")
+	}
+	if _, err := client.InitializeTransaction(&TransactionBody{Amount: "300", Email: "test@test.com", Bearer: BearerAll}); err == nil {
+		t.Error("expected a split-only bearer to be rejected on a transaction")
+	}
+	if _, err := client.CreatePlan(&Plan{Name: "Monthly retainer", Amount: 500000, Interval: "monthy"}); err == nil {
+		t.Error("expected an invalid interval to be rejected")
+	}
+	if _, err := client.CreateSplit(&CreateSplitBody{Name: "Halfsies", Type: "percent", Currency: CurrencyNGN}); err == nil {
+		t.Error("expected an invalid split type to be rejected")
+	}
+	if _, err := client.WhiteListOrBlacklistCustomer(&WhiteListOrBlacklistCustomerBody{Customer: "test@test.com", RiskAction: "block"}); err == nil {
+		t.Error("expected an invalid risk action to be rejected")
+	}
+}
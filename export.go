@@ -0,0 +1,322 @@
+package paystack
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ExportTransactionsParams struct {
+	// From: Only export transactions made from this time
+	From time.Time
+
+	// To: Only export transactions made up to this time
+	To time.Time
+
+	// Status: Only export transactions with this status e.g. success, failed or abandoned
+	Status TransactionStatus
+
+	// Currency: Only export transactions in this currency
+	Currency Currency
+
+	// Settled: Set to export only settled or only unsettled transactions
+	Settled *bool
+
+	// Settlement: Only export the transactions of the settlement with this ID
+	Settlement uint64
+
+	// PaymentPage: Only export the transactions of the payment page with this ID
+	PaymentPage uint64
+
+	// Customer: Only export the transactions of the customer with this ID
+	Customer uint64
+}
+
+func (p *ExportTransactionsParams) values() url.Values {
+	query := url.Values{}
+	if p == nil {
+		return query
+	}
+	if !p.From.IsZero() {
+		query.Set("from", p.From.UTC().Format(time.RFC3339))
+	}
+	if !p.To.IsZero() {
+		query.Set("to", p.To.UTC().Format(time.RFC3339))
+	}
+	if p.Status != "" {
+		query.Set("status", string(p.Status))
+	}
+	if p.Currency != "" {
+		query.Set("currency", string(p.Currency))
+	}
+	if p.Settled != nil {
+		query.Set("settled", strconv.FormatBool(*p.Settled))
+	}
+	if p.Settlement > 0 {
+		query.Set("settlement", strconv.FormatUint(p.Settlement, 10))
+	}
+	if p.PaymentPage > 0 {
+		query.Set("payment_page", strconv.FormatUint(p.PaymentPage, 10))
+	}
+	if p.Customer > 0 {
+		query.Set("customer", strconv.FormatUint(p.Customer, 10))
+	}
+	return query
+}
+
+// TransactionExport is the file a transaction export is written to
+type TransactionExport struct {
+	// Path: Signed url the file can be downloaded from until it expires
+	Path string `json:"path"`
+
+	// ExpiresAt: When the signed url stops working
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// ExportRow is a row of a transaction export. Amounts are left as
+// exported, e.g. 200.00, so no precision is lost.
+type ExportRow struct {
+	ID            string
+	Reference     string
+	Status        TransactionStatus
+	Amount        string
+	Fees          string
+	Currency      Currency
+	Channel       Channel
+	CustomerEmail string
+	CreatedAt     string
+	PaidAt        string
+
+	// Columns: Every column of the row, keyed by its header
+	Columns map[string]string
+}
+
+// Get returns the value of a column, matching the header without regard
+// to case, spaces, underscores or dashes
+func (r *ExportRow) Get(column string) string {
+	if value, ok := r.Columns[column]; ok {
+		return value
+	}
+	column = normalizeColumn(column)
+	for header, value := range r.Columns {
+		if normalizeColumn(header) == column {
+			return value
+		}
+	}
+	return ""
+}
+
+// exportColumns maps normalized export headers to the fields of ExportRow
+var exportColumns = map[string]func(row *ExportRow, value string){
+	"id":                   func(row *ExportRow, value string) { row.ID = value },
+	"transactionid":        func(row *ExportRow, value string) { row.ID = value },
+	"reference":            func(row *ExportRow, value string) { row.Reference = value },
+	"transactionreference": func(row *ExportRow, value string) { row.Reference = value },
+	"status":               func(row *ExportRow, value string) { row.Status = TransactionStatus(value) },
+	"transactionstatus":    func(row *ExportRow, value string) { row.Status = TransactionStatus(value) },
+	"amount":               func(row *ExportRow, value string) { row.Amount = value },
+	"fees":                 func(row *ExportRow, value string) { row.Fees = value },
+	"currency":             func(row *ExportRow, value string) { row.Currency = Currency(value) },
+	"channel":              func(row *ExportRow, value string) { row.Channel = Channel(value) },
+	"paymentchannel":       func(row *ExportRow, value string) { row.Channel = Channel(value) },
+	"email":                func(row *ExportRow, value string) { row.CustomerEmail = value },
+	"customeremail":        func(row *ExportRow, value string) { row.CustomerEmail = value },
+	"createdat":            func(row *ExportRow, value string) { row.CreatedAt = value },
+	"transactiondate":      func(row *ExportRow, value string) { row.CreatedAt = value },
+	"paidat":               func(row *ExportRow, value string) { row.PaidAt = value },
+	"datepaid":             func(row *ExportRow, value string) { row.PaidAt = value },
+}
+
+func normalizeColumn(header string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(header)))
+}
+
+// ExportFile exports the transactions matching params to a
+// csv file and returns the url it can be downloaded from
+//
+// Docs: https://paystack.com/docs/api/#transaction-export
+//
+//	client, _ := paystack.New(apiKey)
+//	file, err := client.Transactions.ExportFile(&paystack.ExportTransactionsParams{Status: paystack.TransactionSuccess})
+func (s *Transactions) ExportFile(params *ExportTransactionsParams) (*DataResponse[TransactionExport], error) {
+	path := withQuery("/transaction/export", params.values())
+
+	file := &DataResponse[TransactionExport]{}
+	if err := s.config.decode("GET", path, nil, file); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// ExportRows exports the transactions matching params and
+// streams the rows of the exported file, which is downloaded with the
+// transport of the client. The file is read as the rows are, so an
+// export is never held in memory. The timeout of the HTTP client bounds
+// the wait for the download to start but not the reading of the rows,
+// which is only cut off by the context of the client. Callers must use
+// WithContext with a deadline or cancel to bound the read, as a stalled
+// download otherwise blocks Next forever. The reader must be closed.
+//
+// Docs: https://paystack.com/docs/api/#transaction-export
+//
+//	client, _ := paystack.New(apiKey)
+//	rows, err := client.Transactions.ExportRows(&paystack.ExportTransactionsParams{From: from, To: to})
+//	if err != nil {
+//		return err
+//	}
+//	defer rows.Close()
+//	for rows.Next() {
+//		row := rows.Row()
+//		fmt.Println(row.Reference, row.Amount, row.Status)
+//	}
+//	if err := rows.Err(); err != nil {
+//		return err
+//	}
+func (s *Transactions) ExportRows(params *ExportTransactionsParams) (*ExportReader, error) {
+	file, err := s.ExportFile(params)
+	if err != nil {
+		return nil, err
+	}
+	if file.Data.Path == "" {
+		return nil, errors.New("export did not return a file path")
+	}
+
+	// the signed url must not be sent the secret key
+	req, err := http.NewRequestWithContext(s.config.context(), "GET", file.Data.Path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	resp, err := s.config.downloadClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading export: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("error downloading export: %w", &APIError{StatusCode: resp.StatusCode})
+	}
+
+	return NewExportReader(resp.Body), nil
+}
+
+// downloadClient returns a copy of the HTTP client without a timeout.
+// http.Client.Timeout also covers reading the body, so it would cut off
+// a large export part way through. The timeout still bounds the wait for
+// the response headers when the transport is an *http.Transport, so a
+// stalled download fails instead of hanging.
+func (c *Config) downloadClient() *http.Client {
+	client := *c.Client
+
+	headerTimeout := client.Timeout
+	if headerTimeout <= 0 {
+		headerTimeout = defaultHTTPTimeout
+	}
+	client.Timeout = 0
+
+	transport, ok := client.Transport.(*http.Transport)
+	if client.Transport == nil {
+		transport, ok = http.DefaultTransport.(*http.Transport)
+	}
+	if ok {
+		transport = transport.Clone()
+		transport.ResponseHeaderTimeout = headerTimeout
+		if transport.DialContext == nil {
+			transport.DialContext = (&net.Dialer{Timeout: headerTimeout}).DialContext
+		}
+		// the clone is dropped after the download, so it keeps no idle connections
+		transport.DisableKeepAlives = true
+		client.Transport = transport
+	}
+	return &client
+}
+
+// ExportReader reads the rows of a transaction export one at a time
+type ExportReader struct {
+	body   io.ReadCloser
+	csv    *csv.Reader
+	header []string
+	row    *ExportRow
+	err    error
+}
+
+// NewExportReader reads the rows of a transaction export from body,
+// e.g. a file downloaded from the dashboard
+func NewExportReader(body io.ReadCloser) *ExportReader {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	return &ExportReader{body: body, csv: reader}
+}
+
+// Next reads the next row, returning false at the end of the export or on an error
+func (r *ExportReader) Next() bool {
+	if r.err != nil {
+		return false
+	}
+
+	if r.header == nil {
+		header, err := r.csv.Read()
+		if err != nil {
+			r.fail(err)
+			return false
+		}
+		r.header = append([]string(nil), header...)
+		if len(r.header) > 0 {
+			// drop the byte order mark spreadsheets expect
+			r.header[0] = strings.TrimPrefix(r.header[0], "\ufeff")
+		}
+	}
+
+	record, err := r.csv.Read()
+	if err != nil {
+		r.fail(err)
+		return false
+	}
+
+	row := &ExportRow{Columns: make(map[string]string, len(r.header))}
+	for i, header := range r.header {
+		if i >= len(record) {
+			break
+		}
+		value := strings.TrimSpace(record[i])
+		row.Columns[header] = value
+		if set, ok := exportColumns[normalizeColumn(header)]; ok {
+			set(row, value)
+		}
+	}
+	r.row = row
+	return true
+}
+
+func (r *ExportReader) fail(err error) {
+	r.row = nil
+	if err == io.EOF {
+		r.err = io.EOF
+		return
+	}
+	r.err = fmt.Errorf("cannot read export: %w", err)
+}
+
+// Row returns the row read by the last call to Next
+func (r *ExportReader) Row() *ExportRow {
+	return r.row
+}
+
+// Err returns the error that stopped Next, or nil at the end of the export
+func (r *ExportReader) Err() error {
+	if r.err == io.EOF {
+		return nil
+	}
+	return r.err
+}
+
+// Close closes the downloaded file
+func (r *ExportReader) Close() error {
+	return r.body.Close()
+}
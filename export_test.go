@@ -0,0 +1,125 @@
+package paystack
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const exportCSV = "\ufeffTransaction Id,Reference,Amount,Currency,Status,Channel,Customer Email,Paid At,Settled\n" +
+	"1504248187,ref-1,200.00,NGN,success,card,customer@email.com,2024-01-02 10:00:00,true\n" +
+	"1504248188,\"ref-2\",\"1,500.50\",NGN,failed,bank,other@email.com,,false\n"
+
+func TestExportTransactionRows(t *testing.T) {
+	var serverURL string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/transaction/export":
+			query := r.URL.Query()
+			if query.Get("status") != "success" || query.Get("settled") != "false" || query.Get("from") != "2024-01-01T00:00:00Z" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"status":true,"data":{"path":"` + serverURL + `/files/export.csv","expiresAt":"2024-01-31 00:00:00"}}`))
+		case "/files/export.csv":
+			if r.Header.Get("Authorization") != "" {
+				t.Error("expected the secret key not to be sent with the download")
+			}
+			_, _ = w.Write([]byte(exportCSV))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	serverURL = client.baseUrl.String()
+
+	settled := false
+	rows, err := FromConfig(client).Transactions.ExportRows(&ExportTransactionsParams{
+		From:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Status:  TransactionSuccess,
+		Settled: &settled,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var got []*ExportRow
+	for rows.Next() {
+		got = append(got, rows.Row())
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(got))
+	}
+	first := got[0]
+	if first.ID != "1504248187" || first.Reference != "ref-1" || first.Amount != "200.00" || first.Currency != CurrencyNGN ||
+		first.Status != TransactionSuccess || first.Channel != ChannelCard || first.CustomerEmail != "customer@email.com" ||
+		first.PaidAt != "2024-01-02 10:00:00" {
+		t.Errorf("unexpected row %+v", first)
+	}
+	if got[1].Amount != "1,500.50" || got[1].Get("settled") != "false" {
+		t.Errorf("unexpected row %+v", got[1])
+	}
+}
+
+func TestExportReaderErrors(t *testing.T) {
+	rows := NewExportReader(io.NopCloser(strings.NewReader("Reference,Amount\n\"ref-1,200\n")))
+	for rows.Next() {
+	}
+	if rows.Err() == nil {
+		t.Error("expected a malformed export to fail")
+	}
+}
+
+func TestExportTransactionRowsTimeout(t *testing.T) {
+	var serverURL string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/transaction/export" {
+			_, _ = w.Write([]byte(`{"status":true,"data":{"path":"` + serverURL + `/files/export.csv"}}`))
+			return
+		}
+		header, rows, _ := strings.Cut(exportCSV, "\n")
+		_, _ = w.Write([]byte(header + "\n"))
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(rows))
+	})
+	serverURL = client.baseUrl.String()
+	client.Client.Timeout = 50 * time.Millisecond
+
+	rows, err := FromConfig(client).Transactions.ExportRows(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	if err := rows.Err(); err != nil || count != 2 {
+		t.Errorf("expected the download to outlive the client timeout, got %d rows, %v", count, err)
+	}
+}
+
+func TestExportTransactionRowsStalledDownload(t *testing.T) {
+	var serverURL string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/transaction/export" {
+			_, _ = w.Write([]byte(`{"status":true,"data":{"path":"` + serverURL + `/files/export.csv"}}`))
+			return
+		}
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(exportCSV))
+	})
+	serverURL = client.baseUrl.String()
+	client.Client.Timeout = 50 * time.Millisecond
+
+	if _, err := FromConfig(client).Transactions.ExportRows(nil); err == nil {
+		t.Error("expected the download to time out waiting for the response")
+	}
+}
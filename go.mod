@@ -1,3 +1,3 @@
 module github.com/rxxcc/paystack-go-sdk
 
-go 1.19
+go 1.21
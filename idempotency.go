@@ -0,0 +1,162 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultOnceAttempts = 3
+	verifyTimeout       = 30 * time.Second
+)
+
+// ErrReferenceExists is returned by Transactions.Initialize when Paystack
+// refuses a reference that was already used, and by
+// Transactions.InitializeOnce when an earlier attempt that timed out had
+// already created the transaction
+var ErrReferenceExists = errors.New("a transaction with this reference already exists")
+
+// defaultReferences generates references for clients without a generator
+var defaultReferences = &SortableReference{}
+
+// InitializeOnce initializes a transaction like Initialize,
+// but a call that times out is only retried once verifying the reference
+// shows the transaction was not created. A reference is generated with
+// Config.References when the body has none and is set on body.
+//
+// When the timed out call did create the transaction, the verified
+// transaction is returned with ErrReferenceExists, as Paystack does not
+// return the authorization url of an existing transaction.
+//
+//	client, _ := paystack.New(apiKey)
+//	body := &paystack.TransactionBody{Email: "customer@email.com", Amount: "20000"}
+//	transaction, err := client.Transactions.InitializeOnce(body)
+//	// body.Reference holds the reference of the transaction
+func (s *Transactions) InitializeOnce(body *TransactionBody) (Response, error) {
+	if body == nil {
+		return nil, errNilBody
+	}
+
+	response, existed, err := s.config.once(&body.Reference, func() (Response, error) {
+		return s.Initialize(body)
+	})
+	if existed {
+		return response, fmt.Errorf("%w: %s", ErrReferenceExists, body.Reference)
+	}
+	return response, err
+}
+
+// ChargeAuthorizationOnce charges an authorization like ChargeAuthorization,
+// but a call that times out is only retried once verifying the reference
+// shows the customer was not charged, so they are never charged twice.
+// A reference is generated with Config.References when the body has none
+// and is set on body.
+//
+// When the timed out call did charge the customer, the verified
+// transaction is returned instead.
+//
+//	client, _ := paystack.New(apiKey)
+//	body := &paystack.ChargeAuthorizationBody{
+//		Email:             "customer@email.com",
+//		Amount:            "20000",
+//		AuthorizationCode: "AUTH_72btv547",
+//		Reference:         paystack.OrderReference("shop", order.ID),
+//	}
+//	charge, err := client.Transactions.ChargeAuthorizationOnce(body)
+func (s *Transactions) ChargeAuthorizationOnce(body *ChargeAuthorizationBody) (Response, error) {
+	if body == nil {
+		return nil, errNilBody
+	}
+
+	response, _, err := s.config.once(&body.Reference, func() (Response, error) {
+		return s.ChargeAuthorization(body)
+	})
+	return response, err
+}
+
+// once makes call with a reference until it succeeds, fails in a way that
+// shows nothing was created, or verifying the reference finds the
+// transaction a timed out attempt created. existed reports the latter.
+func (c *Config) once(reference *string, call func() (Response, error)) (response Response, existed bool, err error) {
+	if *reference == "" {
+		generator := c.References
+		if generator == nil {
+			generator = defaultReferences
+		}
+		generated, err := generator.NewReference()
+		if err != nil {
+			return nil, false, err
+		}
+		*reference = generated
+	}
+
+	ctx := c.context()
+	for attempt := 1; ; attempt++ {
+		response, err = call()
+		if err == nil || !outcomeUnknown(err) {
+			return response, false, err
+		}
+
+		verified, verifyErr := c.verifyReference(ctx, *reference)
+		if verifyErr == nil {
+			return verified, true, nil
+		}
+		if !isReferenceNotFound(verifyErr) {
+			return response, false, fmt.Errorf("cannot tell whether transaction %s was created, verifying it failed with %v: %w", *reference, verifyErr, err)
+		}
+
+		// nothing was created, so the call is safe to make again
+		if attempt >= defaultOnceAttempts || ctx.Err() != nil {
+			return response, false, err
+		}
+	}
+}
+
+// verifyReference verifies a transaction even when ctx is done, as
+// the outcome of a call that timed out must be known before giving up
+func (c *Config) verifyReference(ctx context.Context, reference string) (Response, error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), verifyTimeout)
+	defer cancel()
+
+	return (&Transactions{config: c.WithContext(ctx)}).Verify(reference)
+}
+
+// outcomeUnknown reports whether a call may have reached Paystack without
+// its response coming back, e.g. because it timed out
+func outcomeUnknown(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	var urlErr *url.Error
+	return errors.Is(err, context.DeadlineExceeded) ||
+		(errors.As(err, &netErr) && netErr.Timeout()) ||
+		errors.As(err, &urlErr)
+}
+
+// isReferenceNotFound reports whether verifying a reference failed because
+// Paystack has no transaction with it
+func isReferenceNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound ||
+		(apiErr.StatusCode == http.StatusBadRequest && strings.Contains(strings.ToLower(apiErr.Message), "not found"))
+}
+
+// isDuplicateReference reports whether Paystack refused to initialize a
+// transaction because one with its reference already exists
+func isDuplicateReference(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(apiErr.Message), "duplicate transaction reference")
+}
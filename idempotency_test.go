@@ -0,0 +1,138 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// slowFirstCall answers the first call to path too late for the client and
+// verify calls with 404 until a call to path has gone through
+func slowFirstCall(t *testing.T, path string, createdByTimeout bool) (*Config, func() string) {
+	var mu sync.Mutex
+	var calls []string
+	created := false
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls = append(calls, r.URL.Path)
+		first := len(calls) == 1
+		mu.Unlock()
+
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/transaction/verify/"):
+			mu.Lock()
+			found := created
+			mu.Unlock()
+			if !found {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"status":false,"message":"Transaction reference not found"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":true,"data":{"status":"success"}}`))
+		case r.URL.Path == path && first:
+			mu.Lock()
+			created = createdByTimeout
+			mu.Unlock()
+			time.Sleep(200 * time.Millisecond)
+		default:
+			mu.Lock()
+			created = true
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"status":true,"data":{"status":"success"}}`))
+		}
+	})
+	client.Client.Timeout = 50 * time.Millisecond
+	return client, func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return strings.Join(calls, " ")
+	}
+}
+
+func TestChargeAuthorizationOnce(t *testing.T) {
+	body := func() *ChargeAuthorizationBody {
+		return &ChargeAuthorizationBody{Email: "customer@email.com", Amount: "20000", AuthorizationCode: "AUTH_72btv547"}
+	}
+
+	t.Run("retries when the timed out charge was not made", func(t *testing.T) {
+		client, calls := slowFirstCall(t, "/transaction/charge_authorization", false)
+		charge := body()
+
+		if _, err := FromConfig(client).Transactions.ChargeAuthorizationOnce(charge); err != nil {
+			t.Fatal(err)
+		}
+		if charge.Reference == "" {
+			t.Error("expected a reference to be generated")
+		}
+		want := "/transaction/charge_authorization /transaction/verify/" + charge.Reference + " /transaction/charge_authorization"
+		if got := calls(); got != want {
+			t.Errorf("unexpected calls %s", got)
+		}
+	})
+
+	t.Run("does not charge again when the timed out charge was made", func(t *testing.T) {
+		client, calls := slowFirstCall(t, "/transaction/charge_authorization", true)
+		charge := body()
+		charge.Reference = "order-42"
+
+		response, err := FromConfig(client).Transactions.ChargeAuthorizationOnce(charge)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data, _ := response["data"].(map[string]any); data["status"] != "success" {
+			t.Errorf("expected the verified transaction, got %v", response)
+		}
+		if got := calls(); got != "/transaction/charge_authorization /transaction/verify/order-42" {
+			t.Errorf("unexpected calls %s", got)
+		}
+	})
+
+	t.Run("does not retry calls Paystack rejected", func(t *testing.T) {
+		calls := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Invalid authorization code"}`))
+		})
+
+		if _, err := FromConfig(client).Transactions.ChargeAuthorizationOnce(body()); err == nil {
+			t.Error("expected an error")
+		}
+		if calls != 1 {
+			t.Errorf("expected a single call, got %d", calls)
+		}
+	})
+}
+
+func TestInitializeTransactionOnce(t *testing.T) {
+	client, _ := slowFirstCall(t, "/transaction/initialize", true)
+	client.References = &PrefixedReference{Prefix: "shop"}
+	body := &TransactionBody{Email: "customer@email.com", Amount: "20000"}
+
+	_, err := FromConfig(client).Transactions.InitializeOnce(body)
+	if !errors.Is(err, ErrReferenceExists) {
+		t.Errorf("expected ErrReferenceExists, got %v", err)
+	}
+	if !strings.HasPrefix(body.Reference, "shop-") {
+		t.Errorf("expected the configured generator to be used, got %q", body.Reference)
+	}
+}
+
+func TestInitializeDuplicateReference(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":false,"message":"Duplicate Transaction Reference"}`))
+	})
+
+	_, err := FromConfig(client).Transactions.Initialize(&TransactionBody{Email: "customer@email.com", Amount: "20000", Reference: "order-42"})
+	if !errors.Is(err, ErrReferenceExists) {
+		t.Errorf("expected ErrReferenceExists, got %v", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected the APIError to be kept, got %v", err)
+	}
+}
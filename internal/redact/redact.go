@@ -0,0 +1,166 @@
+// Package redact finds the secrets and personal details in the bodies
+// and query parameters sent to and from Paystack. It is shared by the
+// client's request logging and the recorder in paystacktest, so both
+// hide the same fields.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Keys is a set of body fields and query parameters, compared after
+// lowercasing and dropping underscores and dashes
+type Keys map[string]bool
+
+// Has reports whether key is in the set
+func (k Keys) Has(key string) bool {
+	return k[normalize(key)]
+}
+
+func normalize(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+}
+
+// Sensitive are the fields that hold secrets or card, account and
+// identity details
+var Sensitive = Keys{
+	"number":            true,
+	"cardnumber":        true,
+	"pan":               true,
+	"cvv":               true,
+	"cvc":               true,
+	"pin":               true,
+	"otp":               true,
+	"bvn":               true,
+	"accountnumber":     true,
+	"authorizationcode": true,
+	"documentnumber":    true,
+	"idnumber":          true,
+	"password":          true,
+	"secretkey":         true,
+}
+
+// pathKeys are fields that are only sensitive on some endpoints, e.g.
+// value holds the BVN or ID number sent to /customer/{code}/identification
+var pathKeys = []struct {
+	prefix, suffix string
+	keys           Keys
+}{
+	{prefix: "/customer/", suffix: "/identification", keys: Keys{"value": true}},
+}
+
+// PathKeys returns the fields that are sensitive on the endpoint of path
+func PathKeys(path string) Keys {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	for _, endpoint := range pathKeys {
+		if strings.HasPrefix(path, endpoint.prefix) && strings.HasSuffix(path, endpoint.suffix) {
+			return endpoint.keys
+		}
+	}
+	return nil
+}
+
+// Rules decides what is replaced in a JSON body
+type Rules struct {
+	// Keys: Sets of the fields whose values are replaced
+	Keys []Keys
+
+	// Replace returns what the value of a field in Keys is replaced with.
+	// key is empty for a string that looks like a card number.
+	Replace func(key string, value any) any
+
+	// Text: Optional, rewrites every other string in the body
+	Text func(value string) string
+}
+
+// Match reports whether key is in one of the sets of r
+func (r *Rules) Match(key string) bool {
+	for _, keys := range r.Keys {
+		if keys.Has(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Body returns body with the fields of r replaced. A blank body is
+// returned as is, and a body that is not JSON is an error.
+func (r *Rules) Body(body []byte) ([]byte, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body, nil
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(r.Value(value))
+}
+
+// Value replaces the fields of r in a decoded JSON value, in place
+func (r *Rules) Value(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, inner := range v {
+			if r.Match(key) {
+				v[key] = r.Replace(key, inner)
+				continue
+			}
+			v[key] = r.Value(inner)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = r.Value(v[i])
+		}
+		return v
+	case string:
+		if LooksLikeCardNumber(v) {
+			return r.Replace("", v)
+		}
+		// metadata is sent as stringified JSON
+		if strings.HasPrefix(v, "{") {
+			var inner any
+			if json.Unmarshal([]byte(v), &inner) == nil {
+				if data, err := json.Marshal(r.Value(inner)); err == nil {
+					return string(data)
+				}
+			}
+		}
+		if r.Text != nil {
+			return r.Text(v)
+		}
+		return v
+	}
+	return value
+}
+
+// LooksLikeCardNumber reports whether value is 13 to 19 digits that pass
+// the Luhn check
+func LooksLikeCardNumber(value string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		c := digits[len(digits)-1-i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		digit := int(c - '0')
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,48 @@
+package redact
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLooksLikeCardNumber(t *testing.T) {
+	tests := map[string]bool{
+		"4084084084084081":        true,
+		"4084 0840 8408 4081":     true,
+		"5060-6666-6666-6666-666": true,
+		"4084084084084082":        false,
+		"0123456789":              false,
+		"4084O84084084081":        false,
+	}
+	for value, want := range tests {
+		if got := LooksLikeCardNumber(value); got != want {
+			t.Errorf("LooksLikeCardNumber(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestRulesBody(t *testing.T) {
+	rules := &Rules{
+		Keys:    []Keys{Sensitive, PathKeys("/customer/CUS_xnxdt6s1zg1f4nx/identification?x=1")},
+		Replace: func(string, any) any { return "[REDACTED]" },
+		Text:    strings.ToUpper,
+	}
+
+	body := `{"account_number":"0123456789","value":"22212345678","metadata":"{\"card\":\"4084084084084081\"}","name":"ada"}`
+	data, err := rules.Body([]byte(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"0123456789", "22212345678", "4084084084084081"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("body leaks %s: %s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), `"name":"ADA"`) {
+		t.Errorf("expected other strings to be passed to Text: %s", data)
+	}
+
+	if _, err := rules.Body([]byte("not json")); err == nil {
+		t.Error("expected an error for a body that is not JSON")
+	}
+}
@@ -0,0 +1,108 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrNoAPIKey is returned when no secret key is configured for a call
+var ErrNoAPIKey = errors.New("no api key provided")
+
+// KeyProvider supplies the secret key each call is made with, so one
+// client and its HTTP transport can serve many integrations
+type KeyProvider interface {
+	APIKey(ctx context.Context) (string, error)
+}
+
+// StaticKey is a KeyProvider that always returns the same key
+type StaticKey string
+
+func (k StaticKey) APIKey(ctx context.Context) (string, error) {
+	return string(k), nil
+}
+
+// KeyFunc is a KeyProvider that looks the key up for each call, e.g. from
+// the merchant stored in the context passed to Config.WithContext
+//
+//	client.KeyProvider = paystack.KeyFunc(func(ctx context.Context) (string, error) {
+//		return keys.ForMerchant(ctx, merchantFromContext(ctx))
+//	})
+type KeyFunc func(ctx context.Context) (string, error)
+
+func (f KeyFunc) APIKey(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// RotatingKey is a KeyProvider whose key can be swapped while calls are
+// being made, without rebuilding the client
+//
+//	key := paystack.NewRotatingKey(oldKey)
+//	client.KeyProvider = key
+//	key.Rotate(newKey)
+type RotatingKey struct {
+	key atomic.Value
+}
+
+// NewRotatingKey returns a RotatingKey starting with key
+func NewRotatingKey(key string) *RotatingKey {
+	k := &RotatingKey{}
+	k.key.Store(key)
+	return k
+}
+
+// Rotate replaces the key used by calls made from now on
+func (k *RotatingKey) Rotate(key string) {
+	k.key.Store(key)
+}
+
+func (k *RotatingKey) APIKey(ctx context.Context) (string, error) {
+	key, _ := k.key.Load().(string)
+	return key, nil
+}
+
+type apiKeyContextKey struct{}
+
+// ContextWithAPIKey returns a copy of ctx carrying a secret key that
+// overrides the KeyProvider of the client for calls made with it
+//
+//	ctx = paystack.ContextWithAPIKey(ctx, merchant.SecretKey)
+//	transaction, err := client.WithContext(ctx).Transactions.Verify(reference)
+func ContextWithAPIKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, key)
+}
+
+// WithAPIKey returns a copy of the client whose calls are made with key,
+// sharing the HTTP client and middleware of the original
+//
+//	config, _ := paystack.NewClient(platformKey)
+//	client := paystack.FromConfig(config.WithAPIKey(merchant.SecretKey))
+func (c *Config) WithAPIKey(key string) *Config {
+	clone := c.clone()
+	clone.apiKeyOverride = key
+	return clone
+}
+
+// apiKey returns the key a call is made with. A key set with WithAPIKey
+// wins over one in the context, which wins over the KeyProvider, which
+// wins over ApiKey.
+func (c *Config) apiKey(ctx context.Context) (string, error) {
+	key := c.apiKeyOverride
+	if key == "" {
+		key, _ = ctx.Value(apiKeyContextKey{}).(string)
+	}
+	if key == "" && c.KeyProvider != nil {
+		var err error
+		if key, err = c.KeyProvider.APIKey(ctx); err != nil {
+			return "", fmt.Errorf("cannot get api key: %w", err)
+		}
+	}
+	if key == "" {
+		key = c.ApiKey
+	}
+	if key == "" {
+		return "", ErrNoAPIKey
+	}
+	return key, nil
+}
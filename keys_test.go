@@ -0,0 +1,87 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestKeyProvider(t *testing.T) {
+	var authorization string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"status":true,"data":[]}`))
+	})
+
+	t.Run("rotating key", func(t *testing.T) {
+		key := NewRotatingKey("sk_test_old")
+		client.KeyProvider = key
+
+		_, _ = FromConfig(client).Misc.ListCountries()
+		if authorization != "Bearer sk_test_old" {
+			t.Errorf("unexpected authorization %q", authorization)
+		}
+
+		key.Rotate("sk_test_new")
+		_, _ = FromConfig(client).Misc.ListCountries()
+		if authorization != "Bearer sk_test_new" {
+			t.Errorf("expected the rotated key, got %q", authorization)
+		}
+	})
+
+	t.Run("per-context lookup", func(t *testing.T) {
+		type merchantKey struct{}
+		keys := map[string]string{"acme": "sk_test_acme", "globex": "sk_test_globex"}
+		client.KeyProvider = KeyFunc(func(ctx context.Context) (string, error) {
+			key, ok := keys[ctx.Value(merchantKey{}).(string)]
+			if !ok {
+				return "", errors.New("unknown merchant")
+			}
+			return key, nil
+		})
+
+		for merchant, key := range keys {
+			ctx := context.WithValue(context.Background(), merchantKey{}, merchant)
+			if _, err := FromConfig(client.WithContext(ctx)).Misc.ListCountries(); err != nil {
+				t.Fatal(err)
+			}
+			if authorization != "Bearer "+key {
+				t.Errorf("%s: unexpected authorization %q", merchant, authorization)
+			}
+		}
+
+		ctx := context.WithValue(context.Background(), merchantKey{}, "initech")
+		if _, err := FromConfig(client.WithContext(ctx)).Misc.ListCountries(); err == nil {
+			t.Error("expected the provider error to be returned")
+		}
+	})
+
+	t.Run("per-call overrides", func(t *testing.T) {
+		client.KeyProvider = StaticKey("sk_test_provider")
+
+		ctx := ContextWithAPIKey(context.Background(), "sk_test_context")
+		_, _ = FromConfig(client.WithContext(ctx)).Misc.ListCountries()
+		if authorization != "Bearer sk_test_context" {
+			t.Errorf("expected the context key, got %q", authorization)
+		}
+
+		_, _ = FromConfig(client.WithContext(ctx).WithAPIKey("sk_test_call")).Misc.ListCountries()
+		if authorization != "Bearer sk_test_call" {
+			t.Errorf("expected the per-call key, got %q", authorization)
+		}
+
+		_, _ = FromConfig(client).Misc.ListCountries()
+		if authorization != "Bearer sk_test_provider" {
+			t.Errorf("expected the original client to be unchanged, got %q", authorization)
+		}
+	})
+
+	t.Run("no key", func(t *testing.T) {
+		client.KeyProvider = nil
+		client.ApiKey = ""
+		if _, err := FromConfig(client).Misc.ListCountries(); !errors.Is(err, ErrNoAPIKey) {
+			t.Errorf("expected ErrNoAPIKey, got %v", err)
+		}
+	})
+}
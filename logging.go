@@ -0,0 +1,137 @@
+package paystack
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rxxcc/paystack-go-sdk/internal/redact"
+)
+
+const redacted = "[REDACTED]"
+
+// LogOptions controls what the client logs to Config.Logger
+type LogOptions struct {
+	// Level: Level successful calls are logged at. Defaults to slog.LevelInfo
+	Level slog.Leveler
+
+	// ErrorLevel: Level failed calls are logged at. Defaults to slog.LevelError
+	ErrorLevel slog.Leveler
+
+	// LogBodies: Also log the request headers and the request and response
+	// bodies, with secrets and card, account and identity details redacted
+	LogBodies bool
+}
+
+// logRequests is the outermost Handler when Config.Logger is set
+func (c *Config) logRequests(next Handler) Handler {
+	options := LogOptions{}
+	if c.LogOptions != nil {
+		options = *c.LogOptions
+	}
+	if options.Level == nil {
+		options.Level = slog.LevelInfo
+	}
+	if options.ErrorLevel == nil {
+		options.ErrorLevel = slog.LevelError
+	}
+
+	return func(ctx context.Context, req *Request) (*Result, error) {
+		start := time.Now()
+		result, err := next(ctx, req)
+
+		level := options.Level.Level()
+		if err != nil {
+			level = options.ErrorLevel.Level()
+		}
+		if !c.Logger.Enabled(ctx, level) {
+			return result, err
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("path", redactPath(req.Path)),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if result != nil {
+			attrs = append(attrs,
+				slog.Int("status", result.StatusCode),
+				slog.Int("retries", result.Retries),
+			)
+			if id := requestID(result.Header); id != "" {
+				attrs = append(attrs, slog.String("request_id", id))
+			}
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+		if options.LogBodies {
+			attrs = append(attrs, slog.Any("request_headers", redactHeader(req.Header)))
+			keys := redact.PathKeys(req.Path)
+			if req.Body != nil {
+				attrs = append(attrs, slog.String("request_body", redactBody(req.Body, keys)))
+			}
+			if result != nil {
+				attrs = append(attrs, slog.String("response_body", redactBody(result.Body, keys)))
+			}
+		}
+
+		c.Logger.LogAttrs(ctx, level, "paystack request", attrs...)
+		return result, err
+	}
+}
+
+// requestID returns the id Paystack's edge assigned to the request
+func requestID(header http.Header) string {
+	for _, key := range []string{"X-Request-Id", "Cf-Ray"} {
+		if id := header.Get(key); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func redactHeader(header http.Header) http.Header {
+	clone := header.Clone()
+	if clone.Get("Authorization") != "" {
+		clone.Set("Authorization", redacted)
+	}
+	return clone
+}
+
+// redactPath redacts sensitive query parameters such as account_number
+func redactPath(path string) string {
+	i := strings.IndexByte(path, '?')
+	if i < 0 {
+		return path
+	}
+
+	query, err := url.ParseQuery(path[i+1:])
+	if err != nil {
+		return path[:i] + "?" + redacted
+	}
+	for key := range query {
+		if redact.Sensitive.Has(key) {
+			query[key] = []string{redacted}
+		}
+	}
+	return path[:i] + "?" + query.Encode()
+}
+
+// redactBody returns body with sensitive fields, the extra keys and
+// anything that looks like a card number redacted. Bodies that are not
+// JSON are not logged at all.
+func redactBody(body []byte, extra redact.Keys) string {
+	rules := &redact.Rules{
+		Keys:    []redact.Keys{redact.Sensitive, extra},
+		Replace: func(string, any) any { return redacted },
+	}
+	data, err := rules.Body(body)
+	if err != nil {
+		return redacted
+	}
+	return string(data)
+}
@@ -0,0 +1,141 @@
+package paystack
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rxxcc/paystack-go-sdk/internal/redact"
+)
+
+func TestLogger(t *testing.T) {
+	t.Run("logs each call with secrets redacted", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req_123")
+			_, _ = w.Write([]byte(`{"status":true,"data":{"account_number":"0001234567","account_name":"Doe Jane Loren","card":{"number":"4084084084084081","cvv":"408"}}}`))
+		})
+
+		buf := &bytes.Buffer{}
+		client.Logger = slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		client.LogOptions = &LogOptions{Level: slog.LevelDebug, LogBodies: true}
+
+		if _, err := FromConfig(client).Verification.ResolveAccountNumber("0001234567", "058"); err != nil {
+			t.Fatal(err)
+		}
+
+		var entry map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+			t.Fatalf("cannot decode log entry %q: %s", buf, err)
+		}
+		if entry["level"] != "DEBUG" || entry["method"] != "GET" || entry["status"] != float64(200) || entry["request_id"] != "req_123" {
+			t.Errorf("unexpected log entry %v", entry)
+		}
+		if entry["path"] != "/bank/resolve?account_number=%5BREDACTED%5D&bank_code=058" {
+			t.Errorf("expected the account number to be redacted from the path, got %v", entry["path"])
+		}
+		for _, secret := range []string{"sk_test_xxxx", "0001234567", "4084084084084081", `"408"`} {
+			if strings.Contains(buf.String(), secret) {
+				t.Errorf("log entry leaks %s: %s", secret, buf)
+			}
+		}
+		if !strings.Contains(buf.String(), "Doe Jane Loren") {
+			t.Errorf("expected the rest of the body to be logged: %s", buf)
+		}
+	})
+
+	t.Run("logs failures at the error level", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Invalid BIN"}`))
+		})
+
+		buf := &bytes.Buffer{}
+		client.Logger = slog.New(slog.NewJSONHandler(buf, nil))
+
+		if _, err := FromConfig(client).Verification.ResolveCardBIN("abc"); err == nil {
+			t.Fatal("expected an error")
+		}
+		if !strings.Contains(buf.String(), `"level":"ERROR"`) || !strings.Contains(buf.String(), "Invalid BIN") {
+			t.Errorf("unexpected log entry %s", buf)
+		}
+		if strings.Contains(buf.String(), "response_body") {
+			t.Errorf("bodies should not be logged by default: %s", buf)
+		}
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	body := `{"email":"test@test.com","metadata":"{\"bvn\":\"20012345677\",\"cart_id\":\"8393\"}","card":{"number":"5060 6666 6666 6666 666","pin":"1234"},"otp":"123456"}`
+	redactedBody := redactBody([]byte(body), nil)
+
+	for _, secret := range []string{"20012345677", "5060 6666 6666 6666 666", "1234", "123456"} {
+		if strings.Contains(redactedBody, secret) {
+			t.Errorf("body leaks %s: %s", secret, redactedBody)
+		}
+	}
+	if !strings.Contains(redactedBody, "test@test.com") || !strings.Contains(redactedBody, "8393") {
+		t.Errorf("expected the rest of the body to be kept: %s", redactedBody)
+	}
+	if redactBody([]byte("not json"), nil) != redacted {
+		t.Error("expected a body that is not JSON to be redacted")
+	}
+}
+
+func TestRedactIdentityNumbers(t *testing.T) {
+	t.Run("document and id numbers", func(t *testing.T) {
+		body := `{"type":"bvn","document_number":"22212345678","id_number":"A12345678","country":"NG"}`
+		redactedBody := redactBody([]byte(body), redact.PathKeys("/bank/validate"))
+
+		for _, secret := range []string{"22212345678", "A12345678"} {
+			if strings.Contains(redactedBody, secret) {
+				t.Errorf("body leaks %s: %s", secret, redactedBody)
+			}
+		}
+		if !strings.Contains(redactedBody, `"country":"NG"`) {
+			t.Errorf("expected the rest of the body to be kept: %s", redactedBody)
+		}
+	})
+
+	t.Run("identification value", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"status":true,"message":"Customer Identification in progress"}`))
+		})
+
+		buf := &bytes.Buffer{}
+		client.Logger = slog.New(slog.NewJSONHandler(buf, nil))
+		client.LogOptions = &LogOptions{LogBodies: true}
+
+		body := &ValidateCustomerBody{
+			Country:       "NG",
+			Type:          "bank_account",
+			Value:         "22212345678",
+			BVN:           "20012345677",
+			BankCode:      "007",
+			AccountNumber: "0123456789",
+			FirstName:     "Asta",
+			LastName:      "Lavista",
+		}
+		if _, err := client.ValidateCustomer("CUS_xnxdt6s1zg1f4nx", body); err != nil {
+			t.Fatal(err)
+		}
+		for _, secret := range []string{"22212345678", "20012345677", "0123456789"} {
+			if strings.Contains(buf.String(), secret) {
+				t.Errorf("log entry leaks %s: %s", secret, buf)
+			}
+		}
+		if !strings.Contains(buf.String(), "Lavista") {
+			t.Errorf("expected the rest of the body to be logged: %s", buf)
+		}
+	})
+
+	t.Run("value elsewhere", func(t *testing.T) {
+		redactedBody := redactBody([]byte(`{"value":"Order 8393"}`), redact.PathKeys("/transaction/initialize"))
+		if !strings.Contains(redactedBody, "Order 8393") {
+			t.Errorf("expected value to be kept outside identification calls: %s", redactedBody)
+		}
+	})
+}
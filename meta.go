@@ -0,0 +1,126 @@
+package paystack
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ResponseMeta holds the details of the HTTP response of a call, which
+// Paystack support asks for, e.g. the request ID and exact status code.
+// Bind one to a copy of the client with WithResponseMeta.
+//
+// A ResponseMeta holds the last call made with the copy, so it must not be
+// shared by calls made concurrently.
+type ResponseMeta struct {
+	// CaptureBody: Set to also capture the raw response body, e.g. for auditing
+	CaptureBody bool
+
+	// StatusCode: HTTP status code of the response, 0 when none was received
+	StatusCode int
+
+	// Header: Headers of the response
+	Header http.Header
+
+	// RequestID: ID of the request, read from the X-Request-Id header
+	// or the CF-Ray header Paystack's edge adds to every response
+	RequestID string
+
+	// RateLimit: The rate-limit headers of the response, nil when it had none
+	RateLimit *RateLimitInfo
+
+	// Body: The raw response body, only captured with CaptureBody
+	Body []byte
+
+	// Latency: How long the HTTP round trip took
+	Latency time.Duration
+
+	// Retries: How many times the call was retried before this response
+	Retries int
+}
+
+// RateLimitInfo is read from the X-RateLimit-* headers of a response
+type RateLimitInfo struct {
+	// Limit: Number of calls allowed in the current window, -1 when not sent
+	Limit int
+
+	// Remaining: Number of calls left in the current window, -1 when not sent
+	Remaining int
+
+	// Reset: When the window resets, zero when not sent
+	Reset time.Time
+}
+
+// WithResponseMeta returns a copy of the client that fills meta with the
+// HTTP response of every call made with it. It shares the HTTP client
+// and middleware of the original.
+//
+//	config, _ := paystack.NewClient(apiKey)
+//	meta := &paystack.ResponseMeta{}
+//	err := config.WithResponseMeta(meta).Do(ctx, "GET", "/balance", nil, nil, nil)
+func (c *Config) WithResponseMeta(meta *ResponseMeta) *Config {
+	clone := c.clone()
+	clone.responseMeta = meta
+	return clone
+}
+
+// WithResponseMeta returns a copy of the client that fills meta with the
+// HTTP response of every call made with it, like Config.WithResponseMeta
+//
+//	client, _ := paystack.New(apiKey)
+//	meta := &paystack.ResponseMeta{CaptureBody: true}
+//	transaction, err := client.WithResponseMeta(meta).Transactions.Verify(reference)
+//	if err != nil {
+//		log.Printf("verify failed with status %d, request id %s", meta.StatusCode, meta.RequestID)
+//	}
+func (c *Client) WithResponseMeta(meta *ResponseMeta) *Client {
+	return FromConfig(c.Config.WithResponseMeta(meta))
+}
+
+// reset clears meta before a call, so a call without a response does not
+// leave the details of the one before
+func (m *ResponseMeta) reset() {
+	if m == nil {
+		return
+	}
+	*m = ResponseMeta{CaptureBody: m.CaptureBody}
+}
+
+func (m *ResponseMeta) capture(result *Result) {
+	if m == nil || result == nil {
+		return
+	}
+	m.StatusCode = result.StatusCode
+	m.Header = result.Header.Clone()
+	m.RequestID = requestID(result.Header)
+	m.RateLimit = rateLimitInfo(time.Now(), result.Header)
+	m.Latency = result.Latency
+	m.Retries = result.Retries
+	if m.CaptureBody {
+		m.Body = append([]byte(nil), result.Body...)
+	}
+}
+
+func rateLimitInfo(now time.Time, header http.Header) *RateLimitInfo {
+	limit, remaining, reset := header.Get("X-RateLimit-Limit"), header.Get("X-RateLimit-Remaining"), header.Get("X-RateLimit-Reset")
+	if limit == "" && remaining == "" && reset == "" {
+		return nil
+	}
+
+	info := &RateLimitInfo{Limit: -1, Remaining: -1}
+	if n, err := strconv.Atoi(limit); err == nil {
+		info.Limit = n
+	}
+	if n, err := strconv.Atoi(remaining); err == nil {
+		info.Remaining = n
+	}
+	if n, err := strconv.ParseInt(reset, 10, 64); err == nil {
+		// the reset is either a unix timestamp or a number of seconds
+		if n > now.Unix()-60 {
+			info.Reset = time.Unix(n, 0)
+		} else {
+			info.Reset = now.Add(time.Duration(n) * time.Second)
+		}
+	}
+	return info
+}
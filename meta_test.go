@@ -0,0 +1,98 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestResponseMeta(t *testing.T) {
+	t.Run("captures the response", func(t *testing.T) {
+		reset := time.Now().Add(time.Minute).Unix()
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req_8e1f2b")
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Header().Set("X-RateLimit-Remaining", "97")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+			_, _ = w.Write([]byte(`{"status":true,"data":{"status":"success"}}`))
+		}))
+
+		meta := &ResponseMeta{CaptureBody: true}
+		if _, err := client.WithResponseMeta(meta).Transactions.Verify("ref-1"); err != nil {
+			t.Fatal(err)
+		}
+		if meta.StatusCode != http.StatusOK || meta.RequestID != "req_8e1f2b" {
+			t.Errorf("got status %d and request id %q", meta.StatusCode, meta.RequestID)
+		}
+		if meta.Header.Get("X-Request-Id") != "req_8e1f2b" {
+			t.Errorf("got header %v", meta.Header)
+		}
+		if meta.RateLimit == nil || meta.RateLimit.Limit != 100 || meta.RateLimit.Remaining != 97 || meta.RateLimit.Reset.Unix() != reset {
+			t.Errorf("got rate limit %+v", meta.RateLimit)
+		}
+		if string(meta.Body) != `{"status":true,"data":{"status":"success"}}` {
+			t.Errorf("got body %s", meta.Body)
+		}
+	})
+
+	t.Run("captures failed calls", func(t *testing.T) {
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Cf-Ray", "8a1b2c3d4e5f6a7b-LOS")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Invalid key"}`))
+		}))
+
+		meta := &ResponseMeta{}
+		if _, err := client.WithResponseMeta(meta).Customers.Fetch("CUS_xnxdt6s1zg1f4nx"); err == nil {
+			t.Fatal("expected an error")
+		}
+		if meta.StatusCode != http.StatusBadRequest || meta.RequestID != "8a1b2c3d4e5f6a7b-LOS" {
+			t.Errorf("got status %d and request id %q", meta.StatusCode, meta.RequestID)
+		}
+		if meta.RateLimit != nil {
+			t.Errorf("got rate limit %+v", meta.RateLimit)
+		}
+		if meta.Body != nil {
+			t.Errorf("captured body %s without CaptureBody", meta.Body)
+		}
+	})
+
+	t.Run("is cleared by a call without a response", func(t *testing.T) {
+		client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":true}`))
+		}))
+
+		meta := &ResponseMeta{CaptureBody: true}
+		client = client.WithResponseMeta(meta)
+		if _, err := client.Plans.Fetch("PLN_gx2wn530m0i3w3m"); err != nil {
+			t.Fatal(err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := client.WithContext(ctx).Plans.Fetch("PLN_gx2wn530m0i3w3m"); !errors.Is(err, context.Canceled) {
+			t.Fatalf("got error %v", err)
+		}
+		if meta.StatusCode != 0 || meta.Body != nil || !meta.CaptureBody {
+			t.Errorf("got %+v", meta)
+		}
+	})
+
+	t.Run("is not set on the original client", func(t *testing.T) {
+		config := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":true}`))
+		})
+
+		meta := &ResponseMeta{}
+		_ = config.WithResponseMeta(meta)
+		if err := config.Do(context.Background(), "GET", "/balance", nil, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		if meta.StatusCode != 0 {
+			t.Errorf("original client filled meta %+v", meta)
+		}
+	})
+}
@@ -0,0 +1,248 @@
+package paystack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Metadata is custom data attached to a transaction, charge or customer.
+// It is sent as a JSON object to the customer endpoints and as
+// stringified JSON to the transaction endpoints.
+//
+// Docs: https://paystack.com/docs/payments/metadata
+//
+//	metadata := paystack.NewMetadata().
+//		AddCustomField("Cart ID", "cart_id", "8393").
+//		Set("order_id", 8393)
+type Metadata struct {
+	// CustomFields: Fields shown on the transaction page of the dashboard
+	CustomFields []CustomField
+
+	// CancelAction: Url the customer is redirected to when they cancel the payment
+	CancelAction string
+
+	// CustomFilters: Restrict the banks, cards and mobile money providers a customer can pay with
+	CustomFilters *CustomFilters
+
+	// Fields: Any other key/value pairs
+	Fields map[string]any
+}
+
+type CustomField struct {
+	// DisplayName: The label shown on the dashboard
+	DisplayName string `json:"display_name"`
+
+	// VariableName: The key used to identify the field
+	VariableName string `json:"variable_name"`
+
+	// Value: The value shown on the dashboard
+	Value any `json:"value"`
+}
+
+type CustomFilters struct {
+	// Recurring: Only accept cards that can be charged again
+	Recurring bool `json:"recurring,omitempty"`
+
+	// Banks: Codes of the banks whose cards are accepted
+	Banks []string `json:"banks,omitempty"`
+
+	// CardBrands: Card brands that are accepted e.g. visa, verve, mastercard
+	CardBrands []string `json:"card_brands,omitempty"`
+
+	// SupportedMobileMoneyProviders: Mobile money providers that are accepted e.g. mtn, atl, vod
+	SupportedMobileMoneyProviders []string `json:"supported_mobile_money_providers,omitempty"`
+}
+
+// NewMetadata returns empty metadata to be filled in with the builder methods
+func NewMetadata() *Metadata {
+	return &Metadata{}
+}
+
+// MetadataFrom converts v, typically a struct of your own, to metadata.
+// Its custom_fields, cancel_action and custom_filters keys are picked
+// out, every other key ends up in Fields.
+func MetadataFrom(v any) (*Metadata, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode metadata: %w", err)
+	}
+
+	m := &Metadata{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MetadataAs decodes metadata into T. v can be a *Metadata, the raw JSON
+// of a response, a stringified JSON object or the map[string]any found
+// in a Response, e.g. the metadata of a verified transaction:
+//
+//	transaction, _ := client.Transactions.Verify(reference)
+//	data, _ := transaction["data"].(map[string]any)
+//	order, err := paystack.MetadataAs[Order](data["metadata"])
+func MetadataAs[T any](v any) (T, error) {
+	var out T
+
+	m, ok := v.(*Metadata)
+	if !ok {
+		var data []byte
+		switch raw := v.(type) {
+		case []byte:
+			data = raw
+		case json.RawMessage:
+			data = raw
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return out, fmt.Errorf("cannot encode metadata: %w", err)
+			}
+			data = encoded
+		}
+
+		m = &Metadata{}
+		if err := json.Unmarshal(data, m); err != nil {
+			return out, err
+		}
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return out, fmt.Errorf("cannot decode metadata: %w", err)
+	}
+	return out, nil
+}
+
+// AddCustomField adds a field to be shown on the transaction page of the dashboard
+func (m *Metadata) AddCustomField(displayName, variableName string, value any) *Metadata {
+	m.CustomFields = append(m.CustomFields, CustomField{
+		DisplayName:  displayName,
+		VariableName: variableName,
+		Value:        value,
+	})
+	return m
+}
+
+// SetCancelAction sets the url the customer is redirected to when they cancel the payment
+func (m *Metadata) SetCancelAction(url string) *Metadata {
+	m.CancelAction = url
+	return m
+}
+
+// SetCustomFilters restricts the banks, cards and mobile money providers a customer can pay with
+func (m *Metadata) SetCustomFilters(filters *CustomFilters) *Metadata {
+	m.CustomFilters = filters
+	return m
+}
+
+// Set adds a key/value pair
+func (m *Metadata) Set(key string, value any) *Metadata {
+	if m.Fields == nil {
+		m.Fields = make(map[string]any)
+	}
+	m.Fields[key] = value
+	return m
+}
+
+// Get returns the value of a key, including the value of a custom field
+// with a matching variable name
+func (m *Metadata) Get(key string) (any, bool) {
+	if m == nil {
+		return nil, false
+	}
+	if value, ok := m.Fields[key]; ok {
+		return value, true
+	}
+	for _, field := range m.CustomFields {
+		if field.VariableName == key {
+			return field.Value, true
+		}
+	}
+	return nil, false
+}
+
+// MarshalJSON flattens the metadata into a single JSON object
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	object := make(map[string]any, len(m.Fields)+3)
+	for key, value := range m.Fields {
+		object[key] = value
+	}
+	if len(m.CustomFields) > 0 {
+		object["custom_fields"] = m.CustomFields
+	}
+	if m.CancelAction != "" {
+		object["cancel_action"] = m.CancelAction
+	}
+	if m.CustomFilters != nil {
+		object["custom_filters"] = m.CustomFilters
+	}
+	return json.Marshal(object)
+}
+
+// UnmarshalJSON reads metadata sent back by Paystack, which is either
+// a JSON object, stringified JSON or an empty string
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var stringified string
+		if err := json.Unmarshal(data, &stringified); err != nil {
+			return err
+		}
+		data = []byte(stringified)
+	}
+	if len(bytes.TrimSpace(data)) == 0 || bytes.Equal(data, []byte("null")) {
+		*m = Metadata{}
+		return nil
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return fmt.Errorf("cannot decode metadata: %w", err)
+	}
+
+	decoded := Metadata{}
+	if raw, ok := object["custom_fields"]; ok {
+		if err := json.Unmarshal(raw, &decoded.CustomFields); err != nil {
+			return fmt.Errorf("cannot decode metadata custom_fields: %w", err)
+		}
+		delete(object, "custom_fields")
+	}
+	if raw, ok := object["cancel_action"]; ok {
+		if err := json.Unmarshal(raw, &decoded.CancelAction); err != nil {
+			return fmt.Errorf("cannot decode metadata cancel_action: %w", err)
+		}
+		delete(object, "cancel_action")
+	}
+	if raw, ok := object["custom_filters"]; ok {
+		if err := json.Unmarshal(raw, &decoded.CustomFilters); err != nil {
+			return fmt.Errorf("cannot decode metadata custom_filters: %w", err)
+		}
+		delete(object, "custom_filters")
+	}
+	for key, raw := range object {
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		decoded.Set(key, value)
+	}
+
+	*m = decoded
+	return nil
+}
+
+// stringify returns the metadata as stringified JSON, as the transaction endpoints expect
+func (m *Metadata) stringify() (string, error) {
+	if m == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,100 @@
+package paystack
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testOrder struct {
+	OrderID      int           `json:"order_id"`
+	CustomFields []CustomField `json:"custom_fields"`
+}
+
+func TestMetadataMarshal(t *testing.T) {
+	metadata := NewMetadata().
+		AddCustomField("Cart ID", "cart_id", "8393").
+		SetCancelAction("https://example.com/cancel").
+		Set("order_id", 8393)
+
+	t.Run("stringified for transactions", func(t *testing.T) {
+		data, err := json.Marshal(&TransactionBody{Amount: "300", Email: "test@test.com", Metadata: metadata})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var body struct {
+			Metadata string `json:"metadata"`
+		}
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Metadata != `{"cancel_action":"https://example.com/cancel","custom_fields":[{"display_name":"Cart ID","variable_name":"cart_id","value":"8393"}],"order_id":8393}` {
+			t.Errorf("unexpected metadata %s", body.Metadata)
+		}
+	})
+
+	t.Run("object for customers", func(t *testing.T) {
+		data, err := json.Marshal(&CreateCustomerBody{Email: "test@test.com", Metadata: NewMetadata().Set("tier", "gold")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"email":"test@test.com","metadata":{"tier":"gold"}}` {
+			t.Errorf("unexpected body %s", data)
+		}
+	})
+
+	t.Run("left out when nil", func(t *testing.T) {
+		data, err := json.Marshal(&ChargeAuthorizationBody{Amount: "300", Email: "test@test.com", AuthorizationCode: "AUTH_72btv547"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"amount":"300","email":"test@test.com","authorization_code":"AUTH_72btv547"}` {
+			t.Errorf("unexpected body %s", data)
+		}
+	})
+}
+
+func TestMetadataAs(t *testing.T) {
+	var response Response
+	_ = json.Unmarshal([]byte(`{"status":true,"data":{"metadata":"{\"order_id\":8393,\"custom_fields\":[{\"display_name\":\"Cart ID\",\"variable_name\":\"cart_id\",\"value\":\"8393\"}]}"}}`), &response)
+	data := response["data"].(map[string]any)
+
+	t.Run("from stringified json", func(t *testing.T) {
+		order, err := MetadataAs[testOrder](data["metadata"])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if order.OrderID != 8393 || len(order.CustomFields) != 1 || order.CustomFields[0].VariableName != "cart_id" {
+			t.Errorf("unexpected order %+v", order)
+		}
+	})
+
+	t.Run("from an object", func(t *testing.T) {
+		order, err := MetadataAs[testOrder](map[string]any{"order_id": 12})
+		if err != nil || order.OrderID != 12 {
+			t.Errorf("unexpected order %+v, %v", order, err)
+		}
+	})
+
+	t.Run("from an empty string", func(t *testing.T) {
+		order, err := MetadataAs[testOrder]("")
+		if err != nil || order.OrderID != 0 {
+			t.Errorf("unexpected order %+v, %v", order, err)
+		}
+	})
+
+	t.Run("round trip through MetadataFrom", func(t *testing.T) {
+		metadata, err := MetadataFrom(testOrder{OrderID: 7, CustomFields: []CustomField{{DisplayName: "Order", VariableName: "order", Value: "7"}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value, _ := metadata.Get("order"); value != "7" {
+			t.Errorf("expected the custom field to be picked out, got %v", value)
+		}
+
+		order, err := MetadataAs[testOrder](metadata)
+		if err != nil || order.OrderID != 7 {
+			t.Errorf("unexpected order %+v, %v", order, err)
+		}
+	})
+}
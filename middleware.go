@@ -0,0 +1,70 @@
+package paystack
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Request is an outgoing call to Paystack as seen by middleware.
+// Middleware may change any of its fields before calling the next Handler,
+// e.g. swap the Authorization header or rewrite the body.
+type Request struct {
+	// Method: HTTP method of the call e.g. GET
+	Method string
+
+	// Path: Path of the endpoint including the query string e.g. /transaction/verify/dm9jdrejvp
+	Path string
+
+	// Body: The JSON encoded request body, nil when the call has none
+	Body []byte
+
+	// Header: Headers sent with the call, including Authorization
+	Header http.Header
+}
+
+// Result is the response Paystack sent back for a Request
+type Result struct {
+	// StatusCode: HTTP status code of the response
+	StatusCode int
+
+	// Header: Headers of the response
+	Header http.Header
+
+	// Body: The raw response body
+	Body []byte
+
+	// Latency: How long the HTTP round trip took
+	Latency time.Duration
+
+	// Retries: How many times the call was retried before this result
+	Retries int
+}
+
+// Handler sends a Request to Paystack. It returns a nil Result when no
+// response was received, and an *APIError alongside the Result when
+// Paystack responded with a non-2xx status code.
+type Handler func(ctx context.Context, req *Request) (*Result, error)
+
+// Middleware wraps every call made by the client. It can inspect or change
+// the Request, time and inspect the Result, or short-circuit the call by
+// returning without calling next.
+//
+//	func logCalls(next paystack.Handler) paystack.Handler {
+//		return func(ctx context.Context, req *paystack.Request) (*paystack.Result, error) {
+//			result, err := next(ctx, req)
+//			log.Println(req.Method, req.Path, err)
+//			return result, err
+//		}
+//	}
+type Middleware func(next Handler) Handler
+
+// Use adds middleware to the client. Middleware run in the order they are
+// added, the first one added being the outermost. Use is not safe to call
+// while requests are being made.
+//
+//	client, _ := paystack.NewClient(apiKey)
+//	client.Use(logCalls, countCalls)
+func (c *Config) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
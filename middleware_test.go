@@ -0,0 +1,114 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Run("runs in registration order around the request", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Authorization"); got != "Bearer sk_test_swapped" {
+				t.Errorf("expected the swapped key, got %q", got)
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Transaction reference not found"}`))
+		})
+
+		var calls []string
+		record := func(name string) Middleware {
+			return func(next Handler) Handler {
+				return func(ctx context.Context, req *Request) (*Result, error) {
+					calls = append(calls, name+" "+req.Method+" "+req.Path)
+					result, err := next(ctx, req)
+					var apiErr *APIError
+					if result == nil || result.StatusCode != http.StatusNotFound || !errors.As(err, &apiErr) {
+						t.Errorf("%s: unexpected result %+v, %v", name, result, err)
+					}
+					calls = append(calls, name+" done")
+					return result, err
+				}
+			}
+		}
+		swapKey := func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Result, error) {
+				req.Header.Set("Authorization", "Bearer sk_test_swapped")
+				return next(ctx, req)
+			}
+		}
+		client.Use(record("outer"), record("inner"), swapKey)
+
+		if _, err := client.VerifyTransaction("dm9jdrejvp"); err == nil {
+			t.Error("expected the APIError to be returned")
+		}
+
+		expected := []string{"outer GET /transaction/verify/dm9jdrejvp", "inner GET /transaction/verify/dm9jdrejvp", "inner done", "outer done"}
+		if len(calls) != len(expected) {
+			t.Fatalf("unexpected calls %v", calls)
+		}
+		for i := range expected {
+			if calls[i] != expected[i] {
+				t.Errorf("expected %q, got %q", expected[i], calls[i])
+			}
+		}
+	})
+
+	t.Run("can short-circuit", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		})
+
+		client.Use(func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Result, error) {
+				return &Result{StatusCode: http.StatusOK, Body: []byte(`{"status":true,"data":[{"name":"Alberta","abbreviation":"AB"}]}`)}, nil
+			}
+		})
+
+		states, err := FromConfig(client).Misc.ListStates("CA")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(states.Data) != 1 || states.Data[0].Abbreviation != "AB" {
+			t.Errorf("unexpected states %+v", states.Data)
+		}
+	})
+
+	t.Run("is not shared by copies made afterwards", func(t *testing.T) {
+		tag := func(calls *[]string, name string) Middleware {
+			return func(next Handler) Handler {
+				return func(ctx context.Context, req *Request) (*Result, error) {
+					*calls = append(*calls, name)
+					return &Result{StatusCode: http.StatusOK, Body: []byte(`{"status":true}`)}, nil
+				}
+			}
+		}
+		copies := map[string]func(*Config) *Config{
+			"WithContext":      func(c *Config) *Config { return c.WithContext(context.Background()) },
+			"WithAPIKey":       func(c *Config) *Config { return c.WithAPIKey("sk_test_other") },
+			"WithResponseMeta": func(c *Config) *Config { return c.WithResponseMeta(&ResponseMeta{}) },
+		}
+		for name, copyOf := range copies {
+			var calls []string
+			original := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			})
+			original.middleware = make([]Middleware, 0, 4)
+
+			clone := copyOf(original)
+			clone.Use(tag(&calls, "clone"))
+			original.Use(tag(&calls, "original"))
+
+			if err := clone.Do(context.Background(), http.MethodGet, "/balance", nil, nil, nil); err != nil {
+				t.Fatal(err)
+			}
+			if err := original.Do(context.Background(), http.MethodGet, "/balance", nil, nil, nil); err != nil {
+				t.Fatal(err)
+			}
+			if len(calls) != 2 || calls[0] != "clone" || calls[1] != "original" {
+				t.Errorf("%s: expected each client to run its own middleware, got %v", name, calls)
+			}
+		}
+	})
+}
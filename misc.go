@@ -0,0 +1,160 @@
+// The Miscellaneous API are supporting APIs that can be used to provide more details to other APIs
+
+package paystack
+
+import (
+	"net/url"
+	"strconv"
+)
+
+type Bank struct {
+	ID          uint64 `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Code        string `json:"code"`
+	LongCode    string `json:"longcode"`
+	Gateway     string `json:"gateway"`
+	PayWithBank bool   `json:"pay_with_bank"`
+	Active      bool   `json:"active"`
+	IsDeleted   bool   `json:"is_deleted"`
+	Country     string `json:"country"`
+	Currency    string `json:"currency"`
+	Type        string `json:"type"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+type Country struct {
+	ID                  uint64         `json:"id"`
+	Name                string         `json:"name"`
+	ISOCode             string         `json:"iso_code"`
+	DefaultCurrencyCode string         `json:"default_currency_code"`
+	IntegrationDefaults map[string]any `json:"integration_defaults"`
+	Relationships       map[string]any `json:"relationships"`
+}
+
+type State struct {
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+type ListBanksParams struct {
+	// Country: The country from which to obtain the list of supported banks.
+	// Accepted values are: ghana, kenya, nigeria, south africa
+	Country string
+
+	// Currency: Any of NGN, USD, GHS, KES or ZAR
+	Currency string
+
+	// Type: Type of financial channel. For Ghanaian channels, please use either
+	// mobile_money for mobile money channels OR ghipps for bank channels
+	Type string
+
+	// PayWithBankTransfer: A flag to filter for available banks a customer can make a transfer to complete a payment
+	PayWithBankTransfer bool
+
+	// PayWithBank: A flag to filter for banks a customer can pay directly from
+	PayWithBank bool
+
+	// Gateway: The gateway type of the bank. It can be one of these: [emandate, digitalbankmandate]
+	Gateway string
+
+	// UseCursor: Flag to enable cursor pagination on the endpoint
+	UseCursor bool
+
+	// PerPage: The number of objects to return per page. Defaults to 50, and limited to 100 records per page.
+	PerPage int
+
+	// Next: A cursor that indicates your place in the list. It can be used to fetch the next page of the list
+	Next string
+
+	// Previous: A cursor that indicates your place in the list. It should be used to fetch the previous page of the list after an intial next request
+	Previous string
+}
+
+func (p *ListBanksParams) values() url.Values {
+	query := url.Values{}
+	if p == nil {
+		return query
+	}
+	if p.Country != "" {
+		query.Set("country", p.Country)
+	}
+	if p.Currency != "" {
+		query.Set("currency", p.Currency)
+	}
+	if p.Type != "" {
+		query.Set("type", p.Type)
+	}
+	if p.PayWithBankTransfer {
+		query.Set("pay_with_bank_transfer", "true")
+	}
+	if p.PayWithBank {
+		query.Set("pay_with_bank", "true")
+	}
+	if p.Gateway != "" {
+		query.Set("gateway", p.Gateway)
+	}
+	if p.UseCursor {
+		query.Set("use_cursor", "true")
+	}
+	if p.PerPage > 0 {
+		query.Set("perPage", strconv.Itoa(p.PerPage))
+	}
+	if p.Next != "" {
+		query.Set("next", p.Next)
+	}
+	if p.Previous != "" {
+		query.Set("previous", p.Previous)
+	}
+	return query
+}
+
+// ListBanks gets a list of all supported banks and their properties
+//
+// Docs: https://paystack.com/docs/api/#miscellaneous-bank
+//
+//	client, _ := paystack.New(apiKey)
+//	banks, err := client.Misc.ListBanks(params *ListBanksParams)
+func (s *Misc) ListBanks(params *ListBanksParams) (*ListResponse[Bank], error) {
+	path := withQuery("/bank", params.values())
+
+	banks := &ListResponse[Bank]{}
+	if err := s.config.decode("GET", path, nil, banks); err != nil {
+		return nil, err
+	}
+	return banks, nil
+}
+
+// ListCountries gets a list of countries that Paystack currently supports
+//
+// Docs: https://paystack.com/docs/api/#miscellaneous-country
+//
+//	client, _ := paystack.New(apiKey)
+//	countries, err := client.Misc.ListCountries()
+func (s *Misc) ListCountries() (*ListResponse[Country], error) {
+	path := "/country"
+
+	countries := &ListResponse[Country]{}
+	if err := s.config.decode("GET", path, nil, countries); err != nil {
+		return nil, err
+	}
+	return countries, nil
+}
+
+// ListStates gets a list of states for a country for address verification
+//
+// Docs: https://paystack.com/docs/api/#miscellaneous-avs-states
+//
+//	client, _ := paystack.New(apiKey)
+//	states, err := client.Misc.ListStates(country string)
+func (s *Misc) ListStates(country string) (*ListResponse[State], error) {
+	path := withQuery("/address_verification/states", url.Values{"country": {country}})
+
+	states := &ListResponse[State]{}
+	if err := s.config.decode("GET", path, nil, states); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
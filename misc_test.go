@@ -0,0 +1,75 @@
+package paystack
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestListBanks(t *testing.T) {
+	t.Run("list banks", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			if r.URL.Path != "/bank" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			if query.Get("country") != "nigeria" || query.Get("pay_with_bank_transfer") != "true" || query.Get("perPage") != "2" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{
+				"status": true,
+				"message": "Banks retrieved",
+				"data": [
+					{"id": 9, "name": "Guaranty Trust Bank", "slug": "guaranty-trust-bank", "code": "058", "country": "Nigeria", "currency": "NGN", "type": "nuban", "active": true},
+					{"id": 1, "name": "Access Bank", "slug": "access-bank", "code": "044", "country": "Nigeria", "currency": "NGN", "type": "nuban", "active": true}
+				],
+				"meta": {"next": "YmFuazoxNjk=", "previous": null, "perPage": 2}
+			}`))
+		})
+
+		banks, err := FromConfig(client).Misc.ListBanks(&ListBanksParams{Country: "nigeria", PayWithBankTransfer: true, PerPage: 2, UseCursor: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(banks.Data) != 2 || banks.Data[0].Code != "058" || banks.Data[1].Slug != "access-bank" {
+			t.Errorf("unexpected banks %+v", banks.Data)
+		}
+		if banks.Meta.Next != "YmFuazoxNjk=" || banks.Meta.PerPage != 2 {
+			t.Errorf("unexpected meta %+v", banks.Meta)
+		}
+	})
+}
+
+func TestListCountries(t *testing.T) {
+	t.Run("list countries", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":true,"message":"Countries retrieved","data":[{"id":1,"name":"Nigeria","iso_code":"NG","default_currency_code":"NGN"}]}`))
+		})
+
+		countries, err := FromConfig(client).Misc.ListCountries()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(countries.Data) != 1 || countries.Data[0].ISOCode != "NG" {
+			t.Errorf("unexpected countries %+v", countries.Data)
+		}
+	})
+}
+
+func TestListStates(t *testing.T) {
+	t.Run("list states", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/address_verification/states" || r.URL.Query().Get("country") != "CA" {
+				t.Errorf("unexpected url %s", r.URL)
+			}
+			_, _ = w.Write([]byte(`{"status":true,"message":"States retrieved","data":[{"name":"Alberta","slug":"alberta","abbreviation":"AB"}]}`))
+		})
+
+		states, err := FromConfig(client).Misc.ListStates("CA")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(states.Data) != 1 || states.Data[0].Abbreviation != "AB" {
+			t.Errorf("unexpected states %+v", states.Data)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrPublicKey is returned when a public key is used to call an endpoint that needs a secret key
+var ErrPublicKey = errors.New("public keys (pk_) cannot be used with secret key endpoints")
+
+// Mode is the environment a key belongs to
+type Mode string
+
+const (
+	ModeTest    Mode = "test"
+	ModeLive    Mode = "live"
+	ModeUnknown Mode = "unknown"
+)
+
+// ModeOf returns the mode of a secret or public key from its prefix,
+// e.g. ModeTest for sk_test_ and pk_test_ keys
+func ModeOf(key string) Mode {
+	switch {
+	case strings.HasPrefix(key, "sk_test_"), strings.HasPrefix(key, "pk_test_"):
+		return ModeTest
+	case strings.HasPrefix(key, "sk_live_"), strings.HasPrefix(key, "pk_live_"):
+		return ModeLive
+	}
+	return ModeUnknown
+}
+
+// Option configures a client created with NewClient
+type Option func(*Config)
+
+// RequireMode makes the client refuse keys of any other mode. NewClient
+// returns an error if the key it is given does not match, and every call
+// checks the key it is made with, including keys from a KeyProvider.
+func RequireMode(mode Mode) Option {
+	return func(c *Config) {
+		c.requiredMode = mode
+	}
+}
+
+// RequireTestMode makes the client refuse live keys, e.g. in staging
+//
+//	client, err := paystack.NewClient(os.Getenv("PAYSTACK_SECRET_KEY"), paystack.RequireTestMode())
+func RequireTestMode() Option {
+	return RequireMode(ModeTest)
+}
+
+// RequireLiveMode makes the client refuse test keys, e.g. in production
+func RequireLiveMode() Option {
+	return RequireMode(ModeLive)
+}
+
+// Mode returns the mode of the key the client makes calls with
+func (c *Config) Mode() Mode {
+	key, err := c.apiKey(c.context())
+	if err != nil {
+		return ModeUnknown
+	}
+	return ModeOf(key)
+}
+
+// checkKey refuses public keys and keys that don't match the required mode
+func (c *Config) checkKey(key string) error {
+	if strings.HasPrefix(key, "pk_") {
+		return ErrPublicKey
+	}
+	if c.requiredMode != "" {
+		if mode := ModeOf(key); mode != c.requiredMode {
+			return fmt.Errorf("client requires a %s key but was given a %s key", c.requiredMode, mode)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestMode(t *testing.T) {
+	t.Run("detects the mode from the key prefix", func(t *testing.T) {
+		cases := map[string]Mode{
+			"sk_test_xxxx": ModeTest,
+			"pk_test_xxxx": ModeTest,
+			"sk_live_xxxx": ModeLive,
+			"random":       ModeUnknown,
+		}
+		for key, mode := range cases {
+			if got := ModeOf(key); got != mode {
+				t.Errorf("%s: expected %s, got %s", key, mode, got)
+			}
+		}
+
+		client, _ := NewClient("sk_live_xxxx")
+		if client.Mode() != ModeLive {
+			t.Errorf("expected live mode, got %s", client.Mode())
+		}
+	})
+
+	t.Run("refuses public keys", func(t *testing.T) {
+		if _, err := NewClient("pk_test_xxxx"); !errors.Is(err, ErrPublicKey) {
+			t.Errorf("expected ErrPublicKey, got %v", err)
+		}
+
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		})
+		if _, err := FromConfig(client.WithAPIKey("pk_live_xxxx")).Misc.ListCountries(); !errors.Is(err, ErrPublicKey) {
+			t.Errorf("expected ErrPublicKey, got %v", err)
+		}
+	})
+
+	t.Run("requires the expected environment", func(t *testing.T) {
+		if _, err := NewClient("sk_live_xxxx", RequireTestMode()); err == nil {
+			t.Error("expected a live key to be refused in test mode")
+		}
+		if _, err := NewClient("sk_test_xxxx", RequireLiveMode()); err == nil {
+			t.Error("expected a test key to be refused in live mode")
+		}
+
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		})
+		RequireTestMode()(client)
+		client.KeyProvider = StaticKey("sk_live_xxxx")
+		client.ApiKey = ""
+		if _, err := FromConfig(client).Misc.ListCountries(); err == nil {
+			t.Error("expected a live key from a provider to be refused in test mode")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+module github.com/rxxcc/paystack-go-sdk/otelpaystack
+
+go 1.21
+
+require (
+	github.com/rxxcc/paystack-go-sdk v0.0.0-20261019124224-7064d3933b4a
+	go.opentelemetry.io/otel v1.28.0
+	go.opentelemetry.io/otel/metric v1.28.0
+	go.opentelemetry.io/otel/sdk v1.28.0
+	go.opentelemetry.io/otel/sdk/metric v1.28.0
+	go.opentelemetry.io/otel/trace v1.28.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+)
+
+// Builds inside this repository use the SDK next to this module. Modules
+// that require otelpaystack ignore the replace and use the version above.
+replace github.com/rxxcc/paystack-go-sdk => ../
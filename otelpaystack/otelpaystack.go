@@ -0,0 +1,166 @@
+// Package otelpaystack instruments the Paystack client with OpenTelemetry.
+// It creates a client span per API call and records request count,
+// latency and error metrics per endpoint.
+//
+// It is a module of its own, so the SDK itself does not depend on
+// OpenTelemetry:
+//
+//	go get github.com/rxxcc/paystack-go-sdk/otelpaystack
+//
+//	client, _ := paystack.New(apiKey)
+//	client.Config.Use(otelpaystack.Middleware())
+//	transaction, err := client.WithContext(ctx).Transactions.Verify(reference)
+package otelpaystack
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/rxxcc/paystack-go-sdk/otelpaystack"
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagators    propagation.TextMapPropagator
+}
+
+// Option configures the instrumentation
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider spans are created with.
+// Defaults to the global tracer provider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider metrics are recorded with.
+// Defaults to the global meter provider.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithPropagators sets the propagators used to inject the trace context
+// into outgoing request headers. Defaults to the global propagators.
+func WithPropagators(propagators propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = propagators
+	}
+}
+
+// Middleware returns a paystack.Middleware that traces and measures every
+// call made by the client. Spans are children of the span in the context
+// passed to Config.WithContext.
+func Middleware(opts ...Option) paystack.Middleware {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagators:    otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(instrumentationName)
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	// Errors creating instruments are reported to the global error
+	// handler and leave a no-op instrument behind
+	requests, err := meter.Int64Counter("paystack.client.requests",
+		metric.WithDescription("Number of calls made to the Paystack API"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	failures, err := meter.Int64Counter("paystack.client.errors",
+		metric.WithDescription("Number of calls to the Paystack API that failed"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	duration, err := meter.Float64Histogram("paystack.client.request.duration",
+		metric.WithDescription("Duration of calls to the Paystack API"),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return func(next paystack.Handler) paystack.Handler {
+		return func(ctx context.Context, req *paystack.Request) (*paystack.Result, error) {
+			route := Route(req.Path)
+			attrs := []attribute.KeyValue{
+				attribute.String("http.request.method", req.Method),
+				attribute.String("url.template", route),
+			}
+
+			ctx, span := tracer.Start(ctx, req.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...))
+			defer span.End()
+
+			cfg.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			start := time.Now()
+			result, err := next(ctx, req)
+			elapsed := time.Since(start)
+
+			if result != nil {
+				attrs = append(attrs, attribute.Int("http.response.status_code", result.StatusCode))
+				span.SetAttributes(attribute.Int("http.response.status_code", result.StatusCode))
+				if result.Retries > 0 {
+					span.SetAttributes(attribute.Int("paystack.retries", result.Retries))
+				}
+			}
+			if err != nil {
+				errorType := errorType(err, result)
+				attrs = append(attrs, attribute.String("error.type", errorType))
+				span.SetAttributes(attribute.String("error.type", errorType))
+
+				var apiErr *paystack.APIError
+				if errors.As(err, &apiErr) {
+					span.SetAttributes(attribute.String("paystack.error.message", apiErr.Message))
+				}
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			set := metric.WithAttributes(attrs...)
+			requests.Add(ctx, 1, set)
+			duration.Record(ctx, elapsed.Seconds(), set)
+			if err != nil {
+				failures.Add(ctx, 1, set)
+			}
+
+			return result, err
+		}
+	}
+}
+
+// errorType returns the status code of a failed response,
+// or the type of the error when no response was received
+func errorType(err error, result *paystack.Result) string {
+	if result != nil && result.StatusCode >= 400 {
+		return strconv.Itoa(result.StatusCode)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+	if errors.Is(err, context.Canceled) {
+		return "canceled"
+	}
+	return "_OTHER"
+}
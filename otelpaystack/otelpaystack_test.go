@@ -0,0 +1,112 @@
+package otelpaystack
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRoute(t *testing.T) {
+	cases := map[string]string{
+		"/transaction/verify/dm9jdrejvp":                        "/transaction/verify/{reference}",
+		"/transaction/totals":                                   "/transaction/totals",
+		"/transaction/2":                                        "/transaction/{id}",
+		"/customer/test@test.com":                               "/customer/{email_or_code}",
+		"/subscription/SUB_vsyqdmlzble3uii/manage/link/":        "/subscription/{code}/manage/link",
+		"/bank/resolve?account_number=0001234567&bank_code=058": "/bank/resolve",
+		"/refund/123":                                           "/refund/{path}",
+	}
+	for path, route := range cases {
+		if got := Route(path); got != route {
+			t.Errorf("%s: expected %s, got %s", path, route, got)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	var traceparent string
+	client, _ := paystack.NewClient("sk_test_xxxx")
+	client.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		traceparent = req.Header.Get("Traceparent")
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewBufferString(`{"status":false,"message":"Transaction reference not found"}`)),
+		}, nil
+	})}
+	client.Use(Middleware(
+		WithTracerProvider(tracerProvider),
+		WithMeterProvider(meterProvider),
+		WithPropagators(propagation.TraceContext{}),
+	))
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "checkout")
+	_, err := client.WithContext(ctx).VerifyTransaction("dm9jdrejvp")
+	parent.End()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(ended))
+	}
+	span := ended[0]
+	if span.Name() != "GET /transaction/verify/{reference}" {
+		t.Errorf("unexpected span name %s", span.Name())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("expected the span to be a child of the span in the context")
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected an error status, got %v", span.Status())
+	}
+	attrs := attribute.NewSet(span.Attributes()...)
+	if v, _ := attrs.Value("http.response.status_code"); v.AsInt64() != http.StatusNotFound {
+		t.Errorf("unexpected status code attribute %v", v)
+	}
+	if v, _ := attrs.Value("paystack.error.message"); v.AsString() != "Transaction reference not found" {
+		t.Errorf("unexpected error message attribute %v", v)
+	}
+	if traceparent == "" {
+		t.Error("expected the trace context to be propagated")
+	}
+
+	var metrics metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &metrics); err != nil {
+		t.Fatal(err)
+	}
+	found := map[string]bool{}
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			found[m.Name] = true
+		}
+	}
+	for _, name := range []string{"paystack.client.requests", "paystack.client.errors", "paystack.client.request.duration"} {
+		if !found[name] {
+			t.Errorf("expected metric %s to be recorded", name)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package otelpaystack
+
+import "strings"
+
+// routes are the endpoint templates of the Paystack API. Spans and metrics
+// are named after these instead of the raw path, which carries references,
+// codes and emails.
+var routes = []string{
+	"/transaction",
+	"/transaction/initialize",
+	"/transaction/verify/{reference}",
+	"/transaction/{id}",
+	"/transaction/charge_authorization",
+	"/transaction/check_authorization",
+	"/transaction/timeline/{id_or_reference}",
+	"/transaction/totals",
+	"/transaction/export",
+	"/transaction/partial_debit",
+	"/customer",
+	"/customer/{email_or_code}",
+	"/customer/{code}/identification",
+	"/customer/set_risk_action",
+	"/customer/deactivate_authorization",
+	"/plan",
+	"/plan/{id_or_code}",
+	"/subscription",
+	"/subscription/{id_or_code}",
+	"/subscription/enable",
+	"/subscription/disable",
+	"/subscription/{code}/manage/link",
+	"/subscription/{code}/manage/email",
+	"/split",
+	"/split/{id}",
+	"/split/{id}/subaccount/add",
+	"/split/{id}/subaccount/remove",
+	"/bank",
+	"/bank/resolve",
+	"/bank/validate",
+	"/country",
+	"/address_verification/states",
+	"/decision/bin/{bin}",
+}
+
+// Route returns the endpoint template of a request path, e.g.
+// /transaction/verify/{reference} for /transaction/verify/dm9jdrejvp.
+// Paths of endpoints it does not know keep their first segment and
+// have the rest replaced with {path}.
+func Route(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	best, bestLiterals := "", -1
+	for _, route := range routes {
+		literals, ok := matchRoute(strings.Split(strings.Trim(route, "/"), "/"), segments)
+		if ok && literals > bestLiterals {
+			best, bestLiterals = route, literals
+		}
+	}
+	if best != "" {
+		return best
+	}
+
+	if len(segments) <= 1 {
+		return "/" + segments[0]
+	}
+	return "/" + segments[0] + "/{path}"
+}
+
+// matchRoute reports whether the segments match the route and how many
+// of them matched literally, so /transaction/totals wins over /transaction/{id}
+func matchRoute(route, segments []string) (int, bool) {
+	if len(route) != len(segments) {
+		return 0, false
+	}
+
+	literals := 0
+	for i := range route {
+		if strings.HasPrefix(route[i], "{") {
+			continue
+		}
+		if route[i] != segments[i] {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
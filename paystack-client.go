@@ -2,11 +2,14 @@ package paystack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -17,16 +20,91 @@ const (
 
 type Response map[string]any
 
+// DataResponse is the envelope Paystack wraps around a single resource
+type DataResponse[T any] struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    T      `json:"data"`
+}
+
+// ListResponse is the envelope Paystack wraps around a list of resources
+type ListResponse[T any] struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message"`
+	Data    []T    `json:"data"`
+	Meta    Meta   `json:"meta"`
+}
+
+// Meta holds the pagination details of a list response. Offset pagination
+// populates Total, Skipped, Page and PageCount while cursor pagination
+// populates Next and Previous.
+type Meta struct {
+	Total     int    `json:"total"`
+	Skipped   int    `json:"skipped"`
+	PerPage   int    `json:"perPage"`
+	Page      int    `json:"page"`
+	PageCount int    `json:"pageCount"`
+	Next      string `json:"next"`
+	Previous  string `json:"previous"`
+}
+
+// APIError is returned when Paystack responds with a non-2xx status code
+type APIError struct {
+	// StatusCode: HTTP status code of the response
+	StatusCode int
+
+	// Message: The message Paystack sent back with the error
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Message)
+}
+
 type Config struct {
-	ApiKey  string
-	Client  *http.Client
-	baseUrl *url.URL
+	ApiKey string
+	Client *http.Client
+
+	// KeyProvider: Optional provider of the secret key for each call,
+	// used instead of ApiKey when set
+	KeyProvider KeyProvider
+
+	// Logger: Optional logger every API call is logged to.
+	// Secrets and card, account and identity details are redacted.
+	Logger *slog.Logger
+
+	// LogOptions: Levels and body logging used with Logger, nil uses the defaults
+	LogOptions *LogOptions
+
+	// RateLimits: Optional client-side rate limits, nil sends calls as they are made
+	RateLimits *RateLimits
+
+	// CircuitBreaker: Optional circuit breaker that fails calls fast with
+	// ErrCircuitOpen while Paystack is failing
+	CircuitBreaker *CircuitBreaker
+
+	// References: Generates the reference of calls made with
+	// Transactions.InitializeOnce and Transactions.ChargeAuthorizationOnce without one.
+	// Defaults to a SortableReference
+	References ReferenceGenerator
+
+	baseUrl        *url.URL
+	middleware     []Middleware
+	ctx            context.Context
+	apiKeyOverride string
+	requiredMode   Mode
+	responseMeta   *ResponseMeta
 }
 
-// NewClient instantiates a new paystack client
+// NewClient instantiates the configuration of a paystack client. Use New
+// for a Client with the Paystack APIs grouped into services.
 //
 //	client, err := paystack.NewClient(apiKey string)
-func NewClient(apiKey string) (*Config, error) {
+//	client, err := paystack.NewClient(apiKey string, paystack.RequireTestMode())
+func NewClient(apiKey string, opts ...Option) (*Config, error) {
 	parseURL, _ := url.Parse(baseUrl)
 	c := &Config{
 		ApiKey:  apiKey,
@@ -34,33 +112,125 @@ func NewClient(apiKey string) (*Config, error) {
 		baseUrl: parseURL,
 	}
 
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if apiKey != "" {
+		if err := c.checkKey(apiKey); err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
+// WithContext returns a copy of the client whose requests are made with ctx,
+// so they are cancelled with it and carry its values, e.g. the current trace.
+// The copy shares the HTTP client and middleware of the original.
+//
+//	config, _ := paystack.NewClient(apiKey)
+//	client := paystack.FromConfig(config.WithContext(ctx))
+func (c *Config) WithContext(ctx context.Context) *Config {
+	clone := c.clone()
+	clone.ctx = ctx
+	return clone
+}
+
+// clone returns a shallow copy of the client. The middleware slice is
+// capped at its length, so Use on either the copy or the original
+// appends to a fresh array rather than writing over the other's.
+func (c *Config) clone() *Config {
+	clone := *c
+	clone.middleware = c.middleware[:len(c.middleware):len(c.middleware)]
+	return &clone
+}
+
+func (c *Config) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 // makeRequest function makes a request and send a response to the user
 func (c *Config) makeRequest(method, path string, body any) ([]byte, error) {
-	var buf io.ReadWriter
+	c.responseMeta.reset()
+
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	var encoded []byte
 	if body != nil {
-		buf = new(bytes.Buffer)
+		buf := new(bytes.Buffer)
 		err := json.NewEncoder(buf).Encode(body)
 		if err != nil {
 			return nil, fmt.Errorf("%w", err)
 		}
+		encoded = buf.Bytes()
+	}
+
+	ctx := c.context()
+	apiKey, err := c.apiKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkKey(apiKey); err != nil {
+		return nil, err
+	}
+
+	req := &Request{
+		Method: method,
+		Path:   path,
+		Body:   encoded,
+		Header: http.Header{},
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	handler := c.send
+	if c.CircuitBreaker != nil {
+		handler = c.CircuitBreaker.middleware(handler)
 	}
-	parseUrl, err := c.baseUrl.Parse(baseUrl)
+	if c.RateLimits != nil {
+		handler = c.RateLimits.middleware(handler)
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		handler = c.middleware[i](handler)
+	}
+	if c.Logger != nil {
+		handler = c.logRequests(handler)
+	}
+
+	result, err := handler(ctx, req)
+	c.responseMeta.capture(result)
+	if result == nil {
+		return nil, err
+	}
+	return result.Body, err
+}
+
+// send is the innermost Handler, it performs the HTTP request
+func (c *Config) send(ctx context.Context, req *Request) (*Result, error) {
+	parseUrl, err := c.baseUrl.Parse(req.Path)
 	if err != nil {
 		return nil, fmt.Errorf("%w", err)
 	}
 
-	req, err := http.NewRequest(method, fmt.Sprintf(parseUrl.String()+"%s", path), buf)
+	var body io.Reader
+	if req.Body != nil {
+		body = bytes.NewReader(req.Body)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, parseUrl.String(), body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
+	httpReq.Header = req.Header.Clone()
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+c.ApiKey)
-
-	resp, err := c.Client.Do(req)
+	start := time.Now()
+	resp, err := c.Client.Do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("error getting a response: %w", err)
 	}
@@ -77,7 +247,105 @@ func (c *Config) makeRequest(method, path string, body any) ([]byte, error) {
 		return nil, fmt.Errorf("cannot read response from body: %w", err)
 	}
 
-	return response, nil
+	result := &Result{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       response,
+		Latency:    time.Since(start),
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		var body struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(response, &body) == nil {
+			apiErr.Message = body.Message
+		}
+		return result, apiErr
+	}
+
+	return result, nil
+}
+
+// decode makes a request and unmarshals the response into out
+func (c *Config) decode(method, path string, body, out any) error {
+	response, err := c.makeRequest(method, path, body)
+	if err != nil || out == nil {
+		return err
+	}
+
+	if err := json.Unmarshal(response, out); err != nil {
+		return fmt.Errorf("cannot decode response: %w", err)
+	}
+
+	return nil
+}
+
+// call makes a request and unmarshals the response into a Response, which
+// holds the error body Paystack sent back when the request fails
+func (c *Config) call(method, path string, body any) (Response, error) {
+	response, err := c.makeRequest(method, path, body)
+
+	var result Response
+	_ = json.Unmarshal(response, &result)
+	return result, err
+}
+
+// ListParams pages through the results of a list endpoint
+type ListParams struct {
+	// PerPage: Number of records to return per page. Defaults to 50
+	PerPage int
+
+	// Page: The page to return. Defaults to 1
+	Page int
+
+	// From: Only return records created from this time
+	From time.Time
+
+	// To: Only return records created up to this time
+	To time.Time
+}
+
+func (p *ListParams) values() url.Values {
+	query := url.Values{}
+	if p == nil {
+		return query
+	}
+	if p.PerPage > 0 {
+		query.Set("perPage", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		query.Set("page", strconv.Itoa(p.Page))
+	}
+	if !p.From.IsZero() {
+		query.Set("from", p.From.UTC().Format(time.RFC3339))
+	}
+	if !p.To.IsZero() {
+		query.Set("to", p.To.UTC().Format(time.RFC3339))
+	}
+	return query
+}
+
+// listPath appends the query of params to path. params is variadic so it
+// can be left out, and more than one is an error
+func listPath(path string, params []*ListParams) (string, error) {
+	switch len(params) {
+	case 0:
+		return path, nil
+	case 1:
+		return withQuery(path, params[0].values()), nil
+	default:
+		return "", fmt.Errorf("cannot list %s: expected at most one ListParams, got %d", path, len(params))
+	}
+}
+
+// withQuery appends the encoded query parameters to path
+func withQuery(path string, query url.Values) string {
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
 }
 
 func httpClient() *http.Client {
@@ -0,0 +1,104 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// newTestClient returns a client whose requests are served by handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Config {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("sk_test_xxxx")
+	if err != nil {
+		t.Fatalf("cannot initialize client %s", err)
+	}
+	client.baseUrl, _ = url.Parse(server.URL)
+	return client
+}
+
+func TestMakeRequest(t *testing.T) {
+	t.Run("sends the secret key and path", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Authorization"); got != "Bearer sk_test_xxxx" {
+				t.Errorf("unexpected authorization header %q", got)
+			}
+			if r.URL.Path != "/bank" || r.URL.Query().Get("country") != "ghana" {
+				t.Errorf("unexpected url %s", r.URL)
+			}
+			_, _ = w.Write([]byte(`{"status":true}`))
+		})
+
+		if _, err := client.makeRequest("GET", "/bank?country=ghana", nil); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("returns an APIError for non-2xx responses", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Invalid key"}`))
+		})
+
+		response, err := client.makeRequest("GET", "/bank", nil)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected an APIError, got %v", err)
+		}
+		if apiErr.StatusCode != http.StatusUnauthorized || apiErr.Message != "Invalid key" {
+			t.Errorf("unexpected error %+v", apiErr)
+		}
+		if len(response) == 0 {
+			t.Error("expected the response body to be returned with the error")
+		}
+	})
+}
+
+func TestListParams(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/customer" || query.Get("perPage") != "20" || query.Get("page") != "2" {
+			t.Errorf("unexpected url %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"status":true,"data":[]}`))
+	})
+
+	if _, err := client.ListCustomers(&ListParams{PerPage: 20, Page: 2}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestListParamsMoreThanOne(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	})
+
+	if _, err := FromConfig(client).Customers.List(&ListParams{Page: 1}, &ListParams{Page: 2}); err == nil {
+		t.Error("expected an error for more than one ListParams")
+	}
+}
+
+func TestListTransactionsParams(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/transaction" || query.Get("perPage") != "20" || query.Get("page") != "2" || query.Get("status") != "success" || query.Get("customer") != "42" {
+			t.Errorf("unexpected url %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"status":true,"data":[],"meta":{}}`))
+	})
+
+	params := &ListTransactionsParams{
+		ListParams: ListParams{PerPage: 20, Page: 2},
+		Status:     TransactionSuccess,
+		Customer:   42,
+	}
+	if _, err := FromConfig(client).Transactions.ListPage(params); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,308 @@
+// Package paystacktest provides mocks of the Paystack service interfaces
+// for unit testing code that calls Paystack.
+//
+//	func TestCheckout(t *testing.T) {
+//		transactions := paystacktest.NewTransactionService(t)
+//		transactions.On("Verify", "ref-1").
+//			Return(paystack.Response{"status": true, "data": map[string]any{"status": "success"}}, nil).
+//			Once()
+//
+//		handler := NewCheckoutHandler(transactions)
+//		// ...
+//	}
+//
+// Expectations are asserted when the test ends. A call no expectation
+// matches fails the test and returns ErrUnexpectedCall.
+package paystacktest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// ErrUnexpectedCall is returned by a mock called without a matching expectation
+var ErrUnexpectedCall = errors.New("unexpected call")
+
+// Anything matches any argument
+const Anything = anything("paystacktest.Anything")
+
+type anything string
+
+// ArgumentMatcher matches an argument with a function
+type ArgumentMatcher struct {
+	match func(arg any) bool
+}
+
+// MatchedBy matches the arguments for which match returns true. match
+// must take a single argument of the type of the argument it checks.
+//
+//	transactions.On("Initialize", paystacktest.MatchedBy(func(body *paystack.TransactionBody) bool {
+//		return body.Amount == "20000"
+//	}))
+func MatchedBy(match any) ArgumentMatcher {
+	fn := reflect.ValueOf(match)
+	if fn.Kind() != reflect.Func || fn.Type().NumIn() != 1 || fn.Type().NumOut() != 1 || fn.Type().Out(0).Kind() != reflect.Bool {
+		panic("paystacktest: MatchedBy needs a func(T) bool")
+	}
+	in := fn.Type().In(0)
+
+	return ArgumentMatcher{match: func(arg any) bool {
+		value := reflect.ValueOf(arg)
+		if arg == nil {
+			switch in.Kind() {
+			case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+				value = reflect.Zero(in)
+			default:
+				return false
+			}
+		}
+		if !value.Type().AssignableTo(in) {
+			return false
+		}
+		return fn.Call([]reflect.Value{value})[0].Bool()
+	}}
+}
+
+// Call is a recorded call to a mock
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Expectation is a call a mock expects, and what it returns
+type Expectation struct {
+	method  string
+	args    []any
+	results []any
+	run     func(args []any)
+	times   int
+	calls   int
+}
+
+// Return sets the values returned by the call, in the order of the method's results
+func (e *Expectation) Return(results ...any) *Expectation {
+	e.results = results
+	return e
+}
+
+// Run calls fn with the arguments of every matching call, before it returns
+func (e *Expectation) Run(fn func(args []any)) *Expectation {
+	e.run = fn
+	return e
+}
+
+// Times limits the expectation to n calls, which must all be made
+func (e *Expectation) Times(n int) *Expectation {
+	e.times = n
+	return e
+}
+
+// Once limits the expectation to a single call
+func (e *Expectation) Once() *Expectation {
+	return e.Times(1)
+}
+
+func (e *Expectation) matches(method string, args []any) bool {
+	if e.method != method || (e.times > 0 && e.calls >= e.times) {
+		return false
+	}
+	if e.args == nil {
+		return true
+	}
+	if len(e.args) != len(args) {
+		return false
+	}
+	for i, want := range e.args {
+		if !argumentMatches(want, args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func argumentMatches(want, got any) bool {
+	switch want := want.(type) {
+	case anything:
+		return true
+	case ArgumentMatcher:
+		return want.match(got)
+	}
+	return reflect.DeepEqual(want, got)
+}
+
+// Mock records calls and matches them with expectations. It is embedded
+// in the mock of each service.
+type Mock struct {
+	t testing.TB
+
+	mu           sync.Mutex
+	expectations []*Expectation
+	calls        []Call
+}
+
+func newMock(t testing.TB) Mock {
+	return Mock{t: t}
+}
+
+// register asserts the expectations of m when the test ends
+func register(t testing.TB, m *Mock) {
+	t.Helper()
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+}
+
+// On expects a call to method with args. Leave out args to match any
+// arguments, or use Anything and MatchedBy for some of them. Expectations
+// are matched in the order they were added.
+func (m *Mock) On(method string, args ...any) *Expectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e := &Expectation{method: method, args: args}
+	if len(args) == 0 {
+		e.args = nil
+	}
+	m.expectations = append(m.expectations, e)
+	return e
+}
+
+// Calls returns every call made to the mock
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the calls made to a method
+func (m *Mock) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range m.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// AssertCalled fails the test unless method was called with args
+func (m *Mock) AssertCalled(t testing.TB, method string, args ...any) bool {
+	t.Helper()
+	want := &Expectation{method: method, args: args}
+	if len(args) == 0 {
+		want.args = nil
+	}
+	for _, call := range m.Calls() {
+		if want.matches(call.Method, call.Args) {
+			return true
+		}
+	}
+	t.Errorf("expected a call to %s(%s), got %s", method, formatArgs(args), m.describeCalls())
+	return false
+}
+
+// AssertNotCalled fails the test if method was called
+func (m *Mock) AssertNotCalled(t testing.TB, method string) bool {
+	t.Helper()
+	if calls := m.CallsTo(method); len(calls) > 0 {
+		t.Errorf("expected no calls to %s, got %d", method, len(calls))
+		return false
+	}
+	return true
+}
+
+// AssertExpectations fails the test for every expectation that was not
+// called, or not called as many times as required
+func (m *Mock) AssertExpectations(t testing.TB) bool {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ok := true
+	for _, e := range m.expectations {
+		switch {
+		case e.calls == 0:
+			t.Errorf("expected a call to %s(%s)", e.method, formatArgs(e.args))
+			ok = false
+		case e.times > 0 && e.calls != e.times:
+			t.Errorf("expected %d calls to %s(%s), got %d", e.times, e.method, formatArgs(e.args), e.calls)
+			ok = false
+		}
+	}
+	return ok
+}
+
+// called records a call and returns the results of the expectation it matches
+func (m *Mock) called(method string, args ...any) ([]any, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+
+	var matched *Expectation
+	for _, e := range m.expectations {
+		if e.matches(method, args) {
+			matched = e
+			break
+		}
+	}
+	if matched == nil {
+		m.mu.Unlock()
+		m.t.Helper()
+		m.t.Errorf("unexpected call to %s(%s)", method, formatArgs(args))
+		return nil, fmt.Errorf("%w to %s", ErrUnexpectedCall, method)
+	}
+	matched.calls++
+	run, results := matched.run, matched.results
+	m.mu.Unlock()
+
+	if run != nil {
+		run(args)
+	}
+	return results, nil
+}
+
+func (m *Mock) describeCalls() string {
+	calls := m.Calls()
+	if len(calls) == 0 {
+		return "no calls"
+	}
+	described := make([]string, len(calls))
+	for i, call := range calls {
+		described[i] = fmt.Sprintf("%s(%s)", call.Method, formatArgs(call.Args))
+	}
+	return strings.Join(described, ", ")
+}
+
+func formatArgs(args []any) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = fmt.Sprintf("%+v", arg)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// result returns the result at index i converted to T, or the zero value of
+// T when there is none. The last result of every method is its error.
+func result[T any](m *Mock, method string, results []any, i int) T {
+	var zero T
+	if i >= len(results) || results[i] == nil {
+		return zero
+	}
+	value, ok := results[i].(T)
+	if !ok {
+		m.t.Helper()
+		m.t.Errorf("%s returns %T at position %d, Return was given %T", method, zero, i, results[i])
+	}
+	return value
+}
+
+// errResult returns the error of a call, the error of an unexpected call taking precedence
+func errResult(m *Mock, method string, results []any, i int, err error) error {
+	if err != nil {
+		return err
+	}
+	return result[error](m, method, results, i)
+}
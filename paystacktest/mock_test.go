@@ -0,0 +1,117 @@
+package paystacktest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+// fakeT records the failures a mock reports instead of failing the test
+type fakeT struct {
+	testing.TB
+	errors   []string
+	cleanups []func()
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+func (t *fakeT) finish() {
+	for _, fn := range t.cleanups {
+		fn()
+	}
+}
+
+// verify is the code under test, it only knows the interface
+func verify(transactions paystack.TransactionService, reference string) (string, error) {
+	response, err := transactions.Verify(reference)
+	if err != nil {
+		return "", err
+	}
+	data, _ := response["data"].(map[string]any)
+	status, _ := data["status"].(string)
+	return status, nil
+}
+
+func TestTransactionService(t *testing.T) {
+	transactions := NewTransactionService(t)
+	transactions.On("Verify", "ref-1").
+		Return(paystack.Response{"data": map[string]any{"status": "success"}}, nil).
+		Once()
+	transactions.On("Verify", Anything).
+		Return(nil, errors.New("not found"))
+
+	if status, err := verify(transactions, "ref-1"); err != nil || status != "success" {
+		t.Errorf("unexpected result %q %v", status, err)
+	}
+	if _, err := verify(transactions, "ref-1"); err == nil || err.Error() != "not found" {
+		t.Errorf("expected the second expectation once the first was used up, got %v", err)
+	}
+
+	transactions.AssertCalled(t, "Verify", "ref-1")
+	transactions.AssertNotCalled(t, "ChargeAuthorization")
+	if calls := transactions.CallsTo("Verify"); len(calls) != 2 {
+		t.Errorf("expected 2 recorded calls, got %d", len(calls))
+	}
+}
+
+func TestMatchedBy(t *testing.T) {
+	customers := NewCustomerService(t)
+	customers.On("Create", MatchedBy(func(body *paystack.CreateCustomerBody) bool {
+		return body.Email == "customer@email.com"
+	})).Return(paystack.Response{"status": true}, nil)
+	customers.On("List", &paystack.ListParams{PerPage: 20}).Return(paystack.Response{"data": []any{}}, nil)
+
+	if _, err := customers.Create(&paystack.CreateCustomerBody{Email: "customer@email.com"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := customers.List(&paystack.ListParams{PerPage: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMockFailures(t *testing.T) {
+	ft := &fakeT{}
+	plans := NewPlanService(ft)
+	plans.On("Fetch", "PLN_gx2wn530m0i3w3m").Return(paystack.Response{}, nil).Times(2)
+	plans.On("Create")
+
+	if _, err := plans.Fetch("PLN_gx2wn530m0i3w3m"); err != nil {
+		t.Error(err)
+	}
+	if _, err := plans.Update("PLN_other", &paystack.Plan{}); !errors.Is(err, ErrUnexpectedCall) {
+		t.Errorf("expected ErrUnexpectedCall, got %v", err)
+	}
+	ft.finish()
+
+	want := []string{
+		"unexpected call to Update(PLN_other, &{Name: Amount:0 Interval: Description: SendInvoices:false SendSMS:false Currency: InvoiceLimit:0})",
+		"expected 2 calls to Fetch(PLN_gx2wn530m0i3w3m), got 1",
+		"expected a call to Create()",
+	}
+	if fmt.Sprint(ft.errors) != fmt.Sprint(want) {
+		t.Errorf("unexpected failures\n got: %q\nwant: %q", ft.errors, want)
+	}
+}
+
+func TestMockReturnTypes(t *testing.T) {
+	ft := &fakeT{}
+	subscriptions := NewSubscriptionService(ft)
+	subscriptions.On("EmailToken", "SUB_vsyqdmlzble3uii").Return(42, nil)
+
+	if token, _ := subscriptions.EmailToken("SUB_vsyqdmlzble3uii"); token != "" {
+		t.Errorf("expected the zero value, got %q", token)
+	}
+	if len(ft.errors) != 1 {
+		t.Errorf("expected the wrong return type to be reported, got %q", ft.errors)
+	}
+}
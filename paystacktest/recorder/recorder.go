@@ -0,0 +1,297 @@
+// Package recorder records the calls a Paystack client makes to fixture
+// files and replays them, so tests run fast and without network access.
+//
+// Record the fixture once against the test integration, then commit it
+// and replay it on every run:
+//
+//	rec, err := recorder.New("testdata/verify_transaction.json", recorder.ModeAuto)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	t.Cleanup(func() { _ = rec.Save() })
+//
+//	client, _ := paystack.New(os.Getenv("PAYSTACK_TEST_SECRET_KEY"))
+//	client.Config.Client = rec.Client()
+//
+// Recorded fixtures never hold the secret key, request headers or the
+// personal details of customers, which are scrubbed before they are
+// written. Requests are replayed by matching the method, path, query and
+// body of the scrubbed request.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/rxxcc/paystack-go-sdk/internal/redact"
+)
+
+// ErrNoInteraction is returned when replaying a request the fixture has no response for
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// Mode is what a Recorder does with requests
+type Mode int
+
+const (
+	// ModeReplay answers requests from the fixture and never calls Paystack
+	ModeReplay Mode = iota
+
+	// ModeRecord calls Paystack and records every request and response
+	ModeRecord
+
+	// ModeAuto replays the fixture when it exists and records it otherwise
+	ModeAuto
+)
+
+// Interaction is a recorded request and the response Paystack sent back
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// fixture is the content of a fixture file
+type fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records or replays the calls of a client
+type Recorder struct {
+	// Transport: Makes the real calls while recording. Defaults to http.DefaultTransport
+	Transport http.RoundTripper
+
+	// Scrub: Optional extra scrubbing applied to every interaction before it
+	// is matched or saved, after the built in scrubbing
+	Scrub func(interaction *Interaction)
+
+	path string
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// New returns a recorder for the fixture file at path. The fixture must
+// exist unless recording.
+func New(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil && mode != ModeRecord:
+		f := fixture{}
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("cannot decode fixture %s: %w", path, err)
+		}
+		r.mode = ModeReplay
+		r.interactions = f.Interactions
+		r.replayed = make([]bool, len(f.Interactions))
+	case errors.Is(err, os.ErrNotExist) && mode != ModeReplay:
+		r.mode = ModeRecord
+	case err != nil && mode != ModeRecord:
+		return nil, fmt.Errorf("cannot read fixture: %w", err)
+	}
+	return r, nil
+}
+
+// Recording reports whether the recorder calls Paystack rather than replaying the fixture
+func (r *Recorder) Recording() bool {
+	return r.mode == ModeRecord
+}
+
+// Client returns an HTTP client whose calls go through the recorder
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip records or replays a request
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	request := r.scrubRequest(Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query().Encode(),
+		Body:   string(body),
+	})
+
+	if r.mode != ModeRecord {
+		return r.replay(req, request)
+	}
+
+	if req.Body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := &Interaction{
+		Request: request,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     recordedHeader(resp.Header),
+			Body:       string(responseBody),
+		},
+	}
+	r.scrub(interaction)
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay answers a request with the first recorded response that matches it
+// and has not been replayed yet, so repeated calls get their responses in
+// the order they were recorded
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.replayed[i] || !matches(interaction.Request, request) {
+			continue
+		}
+		r.replayed[i] = true
+
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s in %s", ErrNoInteraction, request.Method, describe(request), r.path)
+}
+
+// Save writes the recorded interactions to the fixture file. It does
+// nothing when replaying.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("cannot create fixture directory: %w", err)
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+// scrubRequest scrubs a request the same way whether it is recorded or
+// replayed, so replayed requests match the scrubbed fixture
+func (r *Recorder) scrubRequest(request Request) Request {
+	interaction := &Interaction{Request: request}
+	r.scrub(interaction)
+	return interaction.Request
+}
+
+func (r *Recorder) scrub(interaction *Interaction) {
+	interaction.Request.Path = scrubText(interaction.Request.Path)
+	interaction.Request.Query = scrubQuery(interaction.Request.Query)
+	extra := redact.PathKeys(interaction.Request.Path)
+	interaction.Request.Body = scrubBody(interaction.Request.Body, extra)
+	interaction.Response.Body = scrubBody(interaction.Response.Body, extra)
+	if r.Scrub != nil {
+		r.Scrub(interaction)
+	}
+}
+
+func matches(recorded, request Request) bool {
+	return recorded.Method == request.Method &&
+		recorded.Path == request.Path &&
+		recorded.Query == request.Query &&
+		canonicalBody(recorded.Body) == canonicalBody(request.Body)
+}
+
+// canonicalBody re-encodes JSON so bodies match regardless of key order and spacing
+func canonicalBody(body string) string {
+	var value any
+	if json.Unmarshal([]byte(body), &value) != nil {
+		return body
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return string(data)
+}
+
+func describe(request Request) string {
+	path := request.Path
+	if request.Query != "" {
+		path += "?" + request.Query
+	}
+	if request.Body != "" {
+		path += " " + request.Body
+	}
+	return path
+}
+
+// recordedHeader keeps the response headers a client may act on
+func recordedHeader(header http.Header) http.Header {
+	kept := http.Header{}
+	for _, key := range []string{"Content-Type", "Retry-After", "X-Ratelimit-Limit", "X-Ratelimit-Remaining", "X-Ratelimit-Reset"} {
+		if values := header.Values(key); len(values) > 0 {
+			kept[key] = values
+		}
+	}
+	return kept
+}
@@ -0,0 +1,218 @@
+package recorder_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+	"github.com/rxxcc/paystack-go-sdk/paystacktest/recorder"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// fakePaystack answers like Paystack, with the status of a transaction
+// changing from ongoing to success between calls
+func fakePaystack(calls *int) http.RoundTripper {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		*calls++
+		body := `{"status":true,"data":{"id":1,"email":"ada@lovelace.dev","first_name":"Ada","customer_code":"CUS_xnxdt6s1zg1f4nx"}}`
+		if strings.HasPrefix(req.URL.Path, "/transaction/verify/") {
+			status := "ongoing"
+			if *calls > 2 {
+				status = "success"
+			}
+			body = `{"status":true,"data":{"reference":"ref-1","status":"` + status + `","customer":{"email":"ada@lovelace.dev","phone":"+2348012345678"}}}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}, "Set-Cookie": {"session=1"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+}
+
+func exercise(t *testing.T, client *paystack.Config) []string {
+	t.Helper()
+
+	var got []string
+	customer, err := client.CreateCustomer(&paystack.CreateCustomerBody{Email: "ada@lovelace.dev", FirstName: "Ada"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := customer["data"].(map[string]any)
+	got = append(got, data["customer_code"].(string))
+
+	for i := 0; i < 2; i++ {
+		transaction, err := client.VerifyTransaction("ref-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := transaction["data"].(map[string]any)
+		got = append(got, data["status"].(string))
+	}
+	return got
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "customer.json")
+	client, _ := paystack.NewClient("sk_test_abcdef123456")
+
+	calls := 0
+	rec, err := recorder.New(path, recorder.ModeAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rec.Recording() {
+		t.Fatal("expected a missing fixture to be recorded")
+	}
+	rec.Transport = fakePaystack(&calls)
+	client.Client = rec.Client()
+	recorded := exercise(t, client)
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	fixture, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"sk_test_abcdef123456", "ada@lovelace.dev", "Ada", "+2348012345678", "Authorization", "session=1"} {
+		if strings.Contains(string(fixture), secret) {
+			t.Errorf("expected %q to be scrubbed from the fixture", secret)
+		}
+	}
+
+	t.Run("replays in the recorded order", func(t *testing.T) {
+		rec, err := recorder.New(path, recorder.ModeAuto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rec.Recording() {
+			t.Fatal("expected an existing fixture to be replayed")
+		}
+		client.Client = rec.Client()
+
+		calls = 0
+		replayed := exercise(t, client)
+		if strings.Join(replayed, " ") != strings.Join(recorded, " ") || strings.Join(replayed, " ") != "CUS_xnxdt6s1zg1f4nx ongoing success" {
+			t.Errorf("expected %v, got %v", recorded, replayed)
+		}
+		if calls != 0 {
+			t.Errorf("expected no calls to Paystack, got %d", calls)
+		}
+	})
+
+	t.Run("fails requests that were not recorded", func(t *testing.T) {
+		rec, _ := recorder.New(path, recorder.ModeReplay)
+		client.Client = rec.Client()
+
+		_, err := client.VerifyTransaction("ref-2")
+		if !errors.Is(err, recorder.ErrNoInteraction) {
+			t.Errorf("expected ErrNoInteraction, got %v", err)
+		}
+	})
+
+	t.Run("replay needs a fixture", func(t *testing.T) {
+		if _, err := recorder.New(filepath.Join(t.TempDir(), "missing.json"), recorder.ModeReplay); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestScrubIdentityNumbers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "identity.json")
+	rec, err := recorder.New(path, recorder.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	rec.Transport = fakePaystack(&calls)
+
+	config, _ := paystack.NewClient("sk_test_abcdef123456")
+	config.Client = rec.Client()
+	client := paystack.FromConfig(config)
+
+	_, err = client.Customers.Validate("CUS_xnxdt6s1zg1f4nx", &paystack.ValidateCustomerBody{
+		Country:       "NG",
+		Type:          "bank_account",
+		Value:         "22212345678",
+		BankCode:      "007",
+		AccountNumber: "0123456789",
+		FirstName:     "Asta",
+		LastName:      "Lavista",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Verification.ValidateAccount(&paystack.ValidateAccountBody{
+		AccountName:    "Ann Bron",
+		AccountNumber:  "0123456789",
+		AccountType:    "personal",
+		BankCode:       "632005",
+		CountryCode:    "ZA",
+		DocumentType:   "identityNumber",
+		DocumentNumber: "1234567890123",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	fixture, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"22212345678", "1234567890123", "0123456789"} {
+		if strings.Contains(string(fixture), secret) {
+			t.Errorf("expected %q to be scrubbed from the fixture", secret)
+		}
+	}
+	if !strings.Contains(string(fixture), "bank_account") {
+		t.Errorf("expected the rest of the body to be kept: %s", fixture)
+	}
+}
+
+func TestScrubCardNumbers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "card.json")
+	rec, err := recorder.New(path, recorder.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	rec.Transport = fakePaystack(&calls)
+
+	config, _ := paystack.NewClient("sk_test_abcdef123456")
+	config.Client = rec.Client()
+	client := paystack.FromConfig(config)
+
+	_, err = client.Transactions.Initialize(&paystack.TransactionBody{
+		Amount:   "30000",
+		Email:    "ada@lovelace.dev",
+		Metadata: paystack.NewMetadata().Set("note", "4084 0840 8408 4081"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	fixture, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(fixture), "4084 0840 8408 4081") {
+		t.Errorf("expected the card number to be scrubbed from the fixture: %s", fixture)
+	}
+}
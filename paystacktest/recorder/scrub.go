@@ -0,0 +1,88 @@
+package recorder
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/rxxcc/paystack-go-sdk/internal/redact"
+)
+
+const (
+	scrubbed      = "[SCRUBBED]"
+	scrubbedEmail = "customer@example.com"
+)
+
+var (
+	secretKeyPattern = regexp.MustCompile(`\b(sk|pk)_(test|live)_[A-Za-z0-9]+`)
+	emailPattern     = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+)
+
+// personalKeys are the fields that are scrubbed along with
+// redact.Sensitive, compared after lowercasing and dropping underscores
+// and dashes
+var personalKeys = redact.Keys{
+	"email":       true,
+	"phone":       true,
+	"firstname":   true,
+	"lastname":    true,
+	"middlename":  true,
+	"accountname": true,
+	"ipaddress":   true,
+}
+
+// isPersonalKey reports whether key is personal or sensitive
+func isPersonalKey(key string) bool {
+	return personalKeys.Has(key) || redact.Sensitive.Has(key)
+}
+
+// scrubText replaces keys and email addresses found anywhere in text
+func scrubText(text string) string {
+	text = secretKeyPattern.ReplaceAllString(text, scrubbed)
+	return emailPattern.ReplaceAllString(text, scrubbedEmail)
+}
+
+func scrubQuery(query string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return scrubText(query)
+	}
+	for key, list := range values {
+		for i := range list {
+			if isPersonalKey(key) {
+				list[i] = scrubbed
+				continue
+			}
+			list[i] = scrubText(list[i])
+		}
+	}
+	return values.Encode()
+}
+
+// scrubBody scrubs the personal fields, extra keys and card numbers of a
+// JSON body, or the keys and email addresses of any other body
+func scrubBody(body string, extra redact.Keys) string {
+	rules := &redact.Rules{
+		Keys:    []redact.Keys{personalKeys, redact.Sensitive, extra},
+		Replace: scrubField,
+		Text:    scrubText,
+	}
+	data, err := rules.Body([]byte(body))
+	if err != nil {
+		return scrubText(body)
+	}
+	return string(data)
+}
+
+// scrubField replaces emails with a placeholder address so fixtures stay
+// valid requests, and leaves null fields as they are
+func scrubField(key string, value any) any {
+	switch {
+	case value == nil:
+		return nil
+	case strings.EqualFold(key, "email"):
+		return scrubbedEmail
+	default:
+		return scrubbed
+	}
+}
@@ -0,0 +1,359 @@
+package paystacktest
+
+import (
+	"context"
+	"testing"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+// TransactionService is a mock of paystack.TransactionService
+type TransactionService struct {
+	Mock
+}
+
+// NewTransactionService returns a mock whose expectations are asserted when the test ends
+func NewTransactionService(t testing.TB) *TransactionService {
+	t.Helper()
+	m := &TransactionService{Mock: newMock(t)}
+	register(t, &m.Mock)
+	return m
+}
+
+func (m *TransactionService) Initialize(body *paystack.TransactionBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Initialize", body)
+	return result[paystack.Response](&m.Mock, "Initialize", results, 0), errResult(&m.Mock, "Initialize", results, 1, err)
+}
+
+func (m *TransactionService) InitializeOnce(body *paystack.TransactionBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("InitializeOnce", body)
+	return result[paystack.Response](&m.Mock, "InitializeOnce", results, 0), errResult(&m.Mock, "InitializeOnce", results, 1, err)
+}
+
+func (m *TransactionService) Verify(reference string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Verify", reference)
+	return result[paystack.Response](&m.Mock, "Verify", results, 0), errResult(&m.Mock, "Verify", results, 1, err)
+}
+
+func (m *TransactionService) Wait(ctx context.Context, reference string, opts *paystack.WaitOptions) (*paystack.Transaction, error) {
+	m.t.Helper()
+	results, err := m.called("Wait", ctx, reference, opts)
+	return result[*paystack.Transaction](&m.Mock, "Wait", results, 0), errResult(&m.Mock, "Wait", results, 1, err)
+}
+
+func (m *TransactionService) List(params ...*paystack.ListParams) (paystack.Response, error) {
+	m.t.Helper()
+	args := make([]any, len(params))
+	for i, p := range params {
+		args[i] = p
+	}
+	results, err := m.called("List", args...)
+	return result[paystack.Response](&m.Mock, "List", results, 0), errResult(&m.Mock, "List", results, 1, err)
+}
+
+func (m *TransactionService) ListPage(params *paystack.ListTransactionsParams) (*paystack.ListResponse[paystack.Transaction], error) {
+	m.t.Helper()
+	results, err := m.called("ListPage", params)
+	return result[*paystack.ListResponse[paystack.Transaction]](&m.Mock, "ListPage", results, 0), errResult(&m.Mock, "ListPage", results, 1, err)
+}
+
+func (m *TransactionService) Fetch(transactionID uint64) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Fetch", transactionID)
+	return result[paystack.Response](&m.Mock, "Fetch", results, 0), errResult(&m.Mock, "Fetch", results, 1, err)
+}
+
+func (m *TransactionService) ChargeAuthorization(body *paystack.ChargeAuthorizationBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("ChargeAuthorization", body)
+	return result[paystack.Response](&m.Mock, "ChargeAuthorization", results, 0), errResult(&m.Mock, "ChargeAuthorization", results, 1, err)
+}
+
+func (m *TransactionService) ChargeAuthorizationOnce(body *paystack.ChargeAuthorizationBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("ChargeAuthorizationOnce", body)
+	return result[paystack.Response](&m.Mock, "ChargeAuthorizationOnce", results, 0), errResult(&m.Mock, "ChargeAuthorizationOnce", results, 1, err)
+}
+
+func (m *TransactionService) CheckAuthorization(body *paystack.CheckAuthorizationBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("CheckAuthorization", body)
+	return result[paystack.Response](&m.Mock, "CheckAuthorization", results, 0), errResult(&m.Mock, "CheckAuthorization", results, 1, err)
+}
+
+func (m *TransactionService) Timeline(referenceOrID string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Timeline", referenceOrID)
+	return result[paystack.Response](&m.Mock, "Timeline", results, 0), errResult(&m.Mock, "Timeline", results, 1, err)
+}
+
+func (m *TransactionService) Totals() (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Totals")
+	return result[paystack.Response](&m.Mock, "Totals", results, 0), errResult(&m.Mock, "Totals", results, 1, err)
+}
+
+func (m *TransactionService) Export() (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Export")
+	return result[paystack.Response](&m.Mock, "Export", results, 0), errResult(&m.Mock, "Export", results, 1, err)
+}
+
+func (m *TransactionService) ExportFile(params *paystack.ExportTransactionsParams) (*paystack.DataResponse[paystack.TransactionExport], error) {
+	m.t.Helper()
+	results, err := m.called("ExportFile", params)
+	return result[*paystack.DataResponse[paystack.TransactionExport]](&m.Mock, "ExportFile", results, 0), errResult(&m.Mock, "ExportFile", results, 1, err)
+}
+
+func (m *TransactionService) ExportRows(params *paystack.ExportTransactionsParams) (*paystack.ExportReader, error) {
+	m.t.Helper()
+	results, err := m.called("ExportRows", params)
+	return result[*paystack.ExportReader](&m.Mock, "ExportRows", results, 0), errResult(&m.Mock, "ExportRows", results, 1, err)
+}
+
+func (m *TransactionService) PartialDebit(body *paystack.PartialDebitBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("PartialDebit", body)
+	return result[paystack.Response](&m.Mock, "PartialDebit", results, 0), errResult(&m.Mock, "PartialDebit", results, 1, err)
+}
+
+// CustomerService is a mock of paystack.CustomerService
+type CustomerService struct {
+	Mock
+}
+
+// NewCustomerService returns a mock whose expectations are asserted when the test ends
+func NewCustomerService(t testing.TB) *CustomerService {
+	t.Helper()
+	m := &CustomerService{Mock: newMock(t)}
+	register(t, &m.Mock)
+	return m
+}
+
+func (m *CustomerService) Create(body *paystack.CreateCustomerBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Create", body)
+	return result[paystack.Response](&m.Mock, "Create", results, 0), errResult(&m.Mock, "Create", results, 1, err)
+}
+
+func (m *CustomerService) List(params ...*paystack.ListParams) (paystack.Response, error) {
+	m.t.Helper()
+	args := make([]any, len(params))
+	for i, p := range params {
+		args[i] = p
+	}
+	results, err := m.called("List", args...)
+	return result[paystack.Response](&m.Mock, "List", results, 0), errResult(&m.Mock, "List", results, 1, err)
+}
+
+func (m *CustomerService) Fetch(emailOrCode string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Fetch", emailOrCode)
+	return result[paystack.Response](&m.Mock, "Fetch", results, 0), errResult(&m.Mock, "Fetch", results, 1, err)
+}
+
+func (m *CustomerService) Update(code string, body *paystack.UpdateCustomerBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Update", code, body)
+	return result[paystack.Response](&m.Mock, "Update", results, 0), errResult(&m.Mock, "Update", results, 1, err)
+}
+
+func (m *CustomerService) Validate(code string, body *paystack.ValidateCustomerBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Validate", code, body)
+	return result[paystack.Response](&m.Mock, "Validate", results, 0), errResult(&m.Mock, "Validate", results, 1, err)
+}
+
+func (m *CustomerService) SetRiskAction(body *paystack.WhiteListOrBlacklistCustomerBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("SetRiskAction", body)
+	return result[paystack.Response](&m.Mock, "SetRiskAction", results, 0), errResult(&m.Mock, "SetRiskAction", results, 1, err)
+}
+
+func (m *CustomerService) DeactivateAuthorization(body *paystack.DeactivateAuthorizationBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("DeactivateAuthorization", body)
+	return result[paystack.Response](&m.Mock, "DeactivateAuthorization", results, 0), errResult(&m.Mock, "DeactivateAuthorization", results, 1, err)
+}
+
+// PlanService is a mock of paystack.PlanService
+type PlanService struct {
+	Mock
+}
+
+// NewPlanService returns a mock whose expectations are asserted when the test ends
+func NewPlanService(t testing.TB) *PlanService {
+	t.Helper()
+	m := &PlanService{Mock: newMock(t)}
+	register(t, &m.Mock)
+	return m
+}
+
+func (m *PlanService) Create(body *paystack.Plan) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Create", body)
+	return result[paystack.Response](&m.Mock, "Create", results, 0), errResult(&m.Mock, "Create", results, 1, err)
+}
+
+func (m *PlanService) List(params ...*paystack.ListParams) (paystack.Response, error) {
+	m.t.Helper()
+	args := make([]any, len(params))
+	for i, p := range params {
+		args[i] = p
+	}
+	results, err := m.called("List", args...)
+	return result[paystack.Response](&m.Mock, "List", results, 0), errResult(&m.Mock, "List", results, 1, err)
+}
+
+func (m *PlanService) Fetch(codeOrID string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Fetch", codeOrID)
+	return result[paystack.Response](&m.Mock, "Fetch", results, 0), errResult(&m.Mock, "Fetch", results, 1, err)
+}
+
+func (m *PlanService) Update(codeOrID string, body *paystack.Plan) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Update", codeOrID, body)
+	return result[paystack.Response](&m.Mock, "Update", results, 0), errResult(&m.Mock, "Update", results, 1, err)
+}
+
+// SubscriptionService is a mock of paystack.SubscriptionService
+type SubscriptionService struct {
+	Mock
+}
+
+// NewSubscriptionService returns a mock whose expectations are asserted when the test ends
+func NewSubscriptionService(t testing.TB) *SubscriptionService {
+	t.Helper()
+	m := &SubscriptionService{Mock: newMock(t)}
+	register(t, &m.Mock)
+	return m
+}
+
+func (m *SubscriptionService) Create(body *paystack.CreateSubscriptionBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Create", body)
+	return result[paystack.Response](&m.Mock, "Create", results, 0), errResult(&m.Mock, "Create", results, 1, err)
+}
+
+func (m *SubscriptionService) List(params ...*paystack.ListParams) (paystack.Response, error) {
+	m.t.Helper()
+	args := make([]any, len(params))
+	for i, p := range params {
+		args[i] = p
+	}
+	results, err := m.called("List", args...)
+	return result[paystack.Response](&m.Mock, "List", results, 0), errResult(&m.Mock, "List", results, 1, err)
+}
+
+func (m *SubscriptionService) Fetch(codeOrID string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Fetch", codeOrID)
+	return result[paystack.Response](&m.Mock, "Fetch", results, 0), errResult(&m.Mock, "Fetch", results, 1, err)
+}
+
+func (m *SubscriptionService) EmailToken(codeOrID string) (string, error) {
+	m.t.Helper()
+	results, err := m.called("EmailToken", codeOrID)
+	return result[string](&m.Mock, "EmailToken", results, 0), errResult(&m.Mock, "EmailToken", results, 1, err)
+}
+
+func (m *SubscriptionService) Enable(body *paystack.SubscriptionBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Enable", body)
+	return result[paystack.Response](&m.Mock, "Enable", results, 0), errResult(&m.Mock, "Enable", results, 1, err)
+}
+
+func (m *SubscriptionService) EnableByCode(code string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("EnableByCode", code)
+	return result[paystack.Response](&m.Mock, "EnableByCode", results, 0), errResult(&m.Mock, "EnableByCode", results, 1, err)
+}
+
+func (m *SubscriptionService) Disable(body *paystack.SubscriptionBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Disable", body)
+	return result[paystack.Response](&m.Mock, "Disable", results, 0), errResult(&m.Mock, "Disable", results, 1, err)
+}
+
+func (m *SubscriptionService) DisableByCode(code string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("DisableByCode", code)
+	return result[paystack.Response](&m.Mock, "DisableByCode", results, 0), errResult(&m.Mock, "DisableByCode", results, 1, err)
+}
+
+func (m *SubscriptionService) GenerateUpdateLink(code string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("GenerateUpdateLink", code)
+	return result[paystack.Response](&m.Mock, "GenerateUpdateLink", results, 0), errResult(&m.Mock, "GenerateUpdateLink", results, 1, err)
+}
+
+func (m *SubscriptionService) SendUpdateLink(code string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("SendUpdateLink", code)
+	return result[paystack.Response](&m.Mock, "SendUpdateLink", results, 0), errResult(&m.Mock, "SendUpdateLink", results, 1, err)
+}
+
+// SplitService is a mock of paystack.SplitService
+type SplitService struct {
+	Mock
+}
+
+// NewSplitService returns a mock whose expectations are asserted when the test ends
+func NewSplitService(t testing.TB) *SplitService {
+	t.Helper()
+	m := &SplitService{Mock: newMock(t)}
+	register(t, &m.Mock)
+	return m
+}
+
+func (m *SplitService) Create(body *paystack.CreateSplitBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Create", body)
+	return result[paystack.Response](&m.Mock, "Create", results, 0), errResult(&m.Mock, "Create", results, 1, err)
+}
+
+func (m *SplitService) List(params ...*paystack.ListParams) (paystack.Response, error) {
+	m.t.Helper()
+	args := make([]any, len(params))
+	for i, p := range params {
+		args[i] = p
+	}
+	results, err := m.called("List", args...)
+	return result[paystack.Response](&m.Mock, "List", results, 0), errResult(&m.Mock, "List", results, 1, err)
+}
+
+func (m *SplitService) Fetch(id string) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Fetch", id)
+	return result[paystack.Response](&m.Mock, "Fetch", results, 0), errResult(&m.Mock, "Fetch", results, 1, err)
+}
+
+func (m *SplitService) Update(id string, body *paystack.UpdateSplitBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("Update", id, body)
+	return result[paystack.Response](&m.Mock, "Update", results, 0), errResult(&m.Mock, "Update", results, 1, err)
+}
+
+func (m *SplitService) AddSubaccount(id string, body *paystack.AddAndUpdateSplitSubaccountBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("AddSubaccount", id, body)
+	return result[paystack.Response](&m.Mock, "AddSubaccount", results, 0), errResult(&m.Mock, "AddSubaccount", results, 1, err)
+}
+
+func (m *SplitService) RemoveSubaccount(id string, body *paystack.RemoveSubAccountFromSplitBody) (paystack.Response, error) {
+	m.t.Helper()
+	results, err := m.called("RemoveSubaccount", id, body)
+	return result[paystack.Response](&m.Mock, "RemoveSubaccount", results, 0), errResult(&m.Mock, "RemoveSubaccount", results, 1, err)
+}
+
+var (
+	_ paystack.TransactionService  = (*TransactionService)(nil)
+	_ paystack.CustomerService     = (*CustomerService)(nil)
+	_ paystack.PlanService         = (*PlanService)(nil)
+	_ paystack.SubscriptionService = (*SubscriptionService)(nil)
+	_ paystack.SplitService        = (*SplitService)(nil)
+)
@@ -3,7 +3,6 @@
 package paystack
 
 import (
-	"encoding/json"
 	"fmt"
 )
 
@@ -16,7 +15,7 @@ type Plan struct {
 	Amount uint64 `json:"amount"`
 
 	// Interval in words. Valid intervals are: daily, weekly, monthly,biannually, annually.
-	Interval string `json:"interval"`
+	Interval Interval `json:"interval"`
 
 	// A description for this plan
 	Description string `json:"description"`
@@ -28,82 +27,98 @@ type Plan struct {
 	SendSMS bool `json:"send_sms"`
 
 	// Currency in which amount is set. Allowed values are NGN, GHS, ZAR or USD
-	Currency string `json:"currency"`
+	Currency Currency `json:"currency"`
 
 	// Number of invoices to raise during subscription to this plan.
 	// Can be overridden by specifying an invoice_limit while subscribing.
 	InvoiceLimit uint64 `json:"invoice_limit"`
 }
 
-// CreatePlan creates a plan on your integration
+// Validate checks the values set on the plan. Every field is optional
+// so that the same body can be used to update a plan, CreatePlan
+// additionally requires the name, amount and interval.
+func (p *Plan) Validate() error {
+	v := &validation{}
+	p.validate(v)
+	return v.err()
+}
+
+func (p *Plan) validate(v *validation) {
+	if p.Interval != "" && !p.Interval.Valid() {
+		v.add("interval", "has an unsupported interval %q", p.Interval)
+	}
+	v.currency("currency", p.Currency, false)
+}
+
+// validateCreate checks the plan has everything needed to create it
+func (p *Plan) validateCreate() error {
+	if p == nil {
+		return errNilBody
+	}
+	v := &validation{}
+	v.required("name", p.Name)
+	if p.Amount == 0 {
+		v.add("amount", "is required")
+	}
+	if p.Interval == "" {
+		v.add("interval", "is required")
+	}
+	p.validate(v)
+	return v.err()
+}
+
+// Create creates a plan on your integration
 //
 // Docs: https://paystack.com/docs/api/#plan-create
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.CreatePlan(body structs{})
-func (c *Config) CreatePlan(body *Plan) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Plans.Create(body structs{})
+func (s *Plans) Create(body *Plan) (Response, error) {
 	path := "/plan"
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
+	if err := body.validateCreate(); err != nil {
+		return nil, err
 	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+
+	return s.config.call("POST", path, body)
 }
 
-// ListPlans list plans available on your integration
+// List list plans available on your integration
 //
 // Docs: https://paystack.com/docs/api/#plan-list
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.ListPlans()
-func (c *Config) ListPlans() (Response, error) {
-	path := "/plan"
-
-	response, err := c.makeRequest("GET", path, nil)
+//	client, _ := paystack.New(apiKey)
+//	plans, err := client.Plans.List(&paystack.ListParams{PerPage: 20})
+func (s *Plans) List(params ...*ListParams) (Response, error) {
+	path, err := listPath("/plan", params)
 	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
+		return nil, err
 	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+
+	return s.config.call("GET", path, nil)
 }
 
-// FetchPlan gets details of a plan on your integration
+// Fetch gets details of a plan on your integration
 // by specifying either the ID or code
 //
 // Docs: https://paystack.com/docs/api/#plan-fetch
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.FetchPlan(codeOrID string)
-func (c *Config) FetchPlan(codeOrID string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Plans.Fetch(codeOrID string)
+func (s *Plans) Fetch(codeOrID string) (Response, error) {
 	path := fmt.Sprintf("/plan/%s", codeOrID)
 
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
-// UpdatePlan updates a plan details on your integration
+// Update updates a plan details on your integration
 //
 // Docs: https://paystack.com/docs/api/#plan-update
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.UpdatePlan(codeOrID string, body structs{})
-func (c *Config) UpdatePlan(codeOrID string, body *Plan) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Plans.Update(codeOrID string, body structs{})
+func (s *Plans) Update(codeOrID string, body *Plan) (Response, error) {
 	path := fmt.Sprintf("/plan/%s", codeOrID)
 
-	response, err := c.makeRequest("PUT", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("PUT", path, body)
 }
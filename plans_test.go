@@ -1,8 +1,6 @@
 package paystack
 
 import (
-	"encoding/json"
-	"fmt"
 	"testing"
 )
 
@@ -16,54 +14,50 @@ func TestCreatePlan(t *testing.T) {
 	}
 
 	t.Run("create plan", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "create_plan")
 
 		response, err := client.CreatePlan(createPlan)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+		data := responseData(t, response)
+		requireFields(t, data, "plan_code")
+		if data["interval"] != "monthly" || data["amount"] != float64(300) {
+			t.Errorf("unexpected plan %v", data)
+		}
 	})
 }
 
 func TestListPlans(t *testing.T) {
 	t.Run("list plans", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "list_plans")
 
 		response, err := client.ListPlans()
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+		if _, ok := response["data"].([]any); response["status"] != true || !ok {
+			t.Errorf("unexpected response %v", response)
+		}
 	})
 }
 
 func TestFetchPlan(t *testing.T) {
 	t.Run("test plan", func(t *testing.T) {
 		codeOrID := "PLN_gx2wn530m0i3w3m"
-
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "fetch_plan")
 
 		response, err := client.FetchPlan(codeOrID)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+		data := responseData(t, response)
+		if data["plan_code"] != codeOrID {
+			t.Errorf("unexpected plan %v", data)
+		}
 	})
 }
 
@@ -74,18 +68,15 @@ func TestUpdatePlan(t *testing.T) {
 
 	t.Run("create plan", func(t *testing.T) {
 		codeOrID := "PLN_gx2wn530m0i3w3m"
-
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "update_plan")
 
 		response, err := client.UpdatePlan(codeOrID, updatePlan)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+		if response["status"] != true {
+			t.Errorf("unexpected response %v", response)
+		}
 	})
 }
@@ -0,0 +1,258 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultRateLimitRetries = 3
+
+// RateLimits throttles the calls made by the client. A call waits for a
+// token from the Client limiter and from the limiter of its endpoint group,
+// and a call Paystack throttled with a 429 is retried once the limiters
+// allow it.
+//
+//	client, _ := paystack.NewClient(apiKey)
+//	client.RateLimits = &paystack.RateLimits{
+//		Client: paystack.NewRateLimiter(20, 5),
+//		Groups: map[string]*paystack.RateLimiter{
+//			"transaction": paystack.NewRateLimiter(10, 1),
+//		},
+//	}
+type RateLimits struct {
+	// Client: Limiter shared by every call, nil for no client wide limit
+	Client *RateLimiter
+
+	// Groups: Limiters per endpoint group. The group of an endpoint is the
+	// first segment of its path e.g. transaction, customer, plan or split
+	Groups map[string]*RateLimiter
+
+	// MaxRetries: How many times a throttled call is retried.
+	// Defaults to 3, a negative value disables retries
+	MaxRetries int
+}
+
+// RateLimiter is a token bucket that adapts to Paystack's rate limits.
+// When Paystack throttles a call its rate is halved and it holds every
+// call back until the time given in the Retry-After or rate-limit reset
+// headers. It recovers its rate gradually as calls succeed.
+type RateLimiter struct {
+	mu           sync.Mutex
+	limit        float64
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	now          func() time.Time
+}
+
+// NewRateLimiter returns a limiter allowing requestsPerSecond calls on
+// average, with bursts of up to burst calls
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		limit:  requestsPerSecond,
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// Rate returns the current rate of the limiter in calls per second,
+// which is lower than the configured rate after Paystack throttled calls
+func (l *RateLimiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rate
+}
+
+// Wait blocks until the limiter allows a call or ctx is done. It returns
+// straight away if the wait would outlast the deadline of ctx.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return fmt.Errorf("rate limit wait of %s exceeds the context deadline: %w", delay, context.DeadlineExceeded)
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token and returns zero, or returns how long to wait before trying again
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	if l.rate <= 0 {
+		return time.Second
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// observe adapts the limiter to the response of a call
+func (l *RateLimiter) observe(statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if until, ok := rateLimitedUntil(now, statusCode, header); ok && until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+
+	if statusCode == http.StatusTooManyRequests {
+		l.rate /= 2
+		if floor := l.limit / 16; l.rate < floor {
+			l.rate = floor
+		}
+		l.tokens = 0
+		return
+	}
+
+	if l.rate < l.limit {
+		l.rate += l.limit / 20
+		if l.rate > l.limit {
+			l.rate = l.limit
+		}
+	}
+}
+
+// rateLimitedUntil reads when calls may resume from the Retry-After header
+// of a 429, or from the rate-limit headers once the remaining calls run out
+func rateLimitedUntil(now time.Time, statusCode int, header http.Header) (time.Time, bool) {
+	if statusCode == http.StatusTooManyRequests {
+		if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil {
+				return now.Add(time.Duration(seconds) * time.Second), true
+			}
+			if at, err := http.ParseTime(retryAfter); err == nil {
+				return at, true
+			}
+		}
+	}
+
+	if remaining := header.Get("X-RateLimit-Remaining"); remaining == "0" {
+		if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			// the reset is either a unix timestamp or a number of seconds
+			if reset > now.Unix()-60 {
+				return time.Unix(reset, 0), true
+			}
+			return now.Add(time.Duration(reset) * time.Second), true
+		}
+	}
+
+	if statusCode == http.StatusTooManyRequests {
+		return now.Add(time.Second), true
+	}
+	return time.Time{}, false
+}
+
+// endpointGroup returns the first segment of path, e.g. transaction for /transaction/verify/dm9jdrejvp
+func endpointGroup(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.IndexAny(path, "/?"); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+// limiters returns the limiters a call to path waits on
+func (r *RateLimits) limiters(path string) []*RateLimiter {
+	var limiters []*RateLimiter
+	if r.Client != nil {
+		limiters = append(limiters, r.Client)
+	}
+	if group := r.Groups[endpointGroup(path)]; group != nil {
+		limiters = append(limiters, group)
+	}
+	return limiters
+}
+
+// middleware waits for the limiters before each call and retries calls Paystack throttled
+func (r *RateLimits) middleware(next Handler) Handler {
+	maxRetries := r.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultRateLimitRetries
+	}
+
+	return func(ctx context.Context, req *Request) (*Result, error) {
+		limiters := r.limiters(req.Path)
+
+		for attempt := 0; ; attempt++ {
+			for _, limiter := range limiters {
+				if err := limiter.Wait(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			result, err := next(ctx, req)
+			if result == nil {
+				return result, err
+			}
+			result.Retries = attempt
+
+			for _, limiter := range limiters {
+				limiter.observe(result.StatusCode, result.Header)
+			}
+			if result.StatusCode != http.StatusTooManyRequests || attempt >= maxRetries {
+				return result, err
+			}
+
+			// without limiters to hold the retry back, wait as Paystack asked
+			if len(limiters) == 0 {
+				until, _ := rateLimitedUntil(time.Now(), result.StatusCode, result.Header)
+				if err := sleep(ctx, time.Until(until)); err != nil {
+					return result, err
+				}
+			}
+		}
+	}
+}
+
+// sleep pauses for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,108 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	t.Run("allows bursts then throttles", func(t *testing.T) {
+		limiter := NewRateLimiter(1, 2)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		for i := 0; i < 2; i++ {
+			if err := limiter.Wait(ctx); err != nil {
+				t.Fatalf("call %d: %s", i, err)
+			}
+		}
+
+		start := time.Now()
+		if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected the wait to exceed the deadline, got %v", err)
+		}
+		if time.Since(start) > 25*time.Millisecond {
+			t.Error("expected Wait to return straight away when it cannot make the deadline")
+		}
+	})
+
+	t.Run("blocks until the reset time", func(t *testing.T) {
+		limiter := NewRateLimiter(100, 10)
+		now := time.Now()
+		limiter.now = func() time.Time { return now }
+
+		limiter.observe(http.StatusOK, http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"30"}})
+		if delay := limiter.reserve(); delay != 30*time.Second {
+			t.Errorf("expected to wait for the reset, got %s", delay)
+		}
+	})
+}
+
+func TestRateLimits(t *testing.T) {
+	t.Run("retries throttled calls and adapts the rate", func(t *testing.T) {
+		calls := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"status":false,"message":"Too many requests"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":true,"data":{"bin":"539983","brand":"Mastercard"}}`))
+		})
+
+		bins := NewRateLimiter(1000, 1)
+		customers := NewRateLimiter(1000, 1)
+		client.RateLimits = &RateLimits{
+			Groups: map[string]*RateLimiter{"decision": bins, "customer": customers},
+		}
+
+		var retries int
+		client.Use(func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Result, error) {
+				result, err := next(ctx, req)
+				if result != nil {
+					retries = result.Retries
+				}
+				return result, err
+			}
+		})
+
+		card, err := FromConfig(client).Verification.ResolveCardBIN("539983")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if card.Data.Brand != "Mastercard" || calls != 2 || retries != 1 {
+			t.Errorf("expected one retry, got %d calls and %d retries", calls, retries)
+		}
+		if rate := bins.Rate(); rate != 550 {
+			t.Errorf("expected the rate to be halved then recover a step, got %v", rate)
+		}
+		if rate := customers.Rate(); rate != 1000 {
+			t.Errorf("expected other groups to be left alone, got %v", rate)
+		}
+	})
+
+	t.Run("gives up after MaxRetries", func(t *testing.T) {
+		calls := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		client.RateLimits = &RateLimits{MaxRetries: 2}
+
+		_, err := FromConfig(client).Misc.ListCountries()
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("expected a 429 APIError, got %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+}
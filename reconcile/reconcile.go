@@ -0,0 +1,192 @@
+// Package reconcile compares the payments recorded in your ledger with the
+// transactions on Paystack and reports where they disagree.
+//
+//	client, _ := paystack.New(apiKey)
+//	report, err := reconcile.Reconcile(ctx, client, ledger, from, to, nil)
+//	if err != nil {
+//		return err
+//	}
+//	err = report.WriteCSV(os.Stdout)
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+const defaultPerPage = 100
+
+// Entry is a payment as recorded on one side of the reconciliation
+type Entry struct {
+	// Reference: The transaction reference the payment was made with
+	Reference string `json:"reference"`
+
+	// Amount: In the subunit of the currency e.g. kobo
+	Amount uint64 `json:"amount"`
+
+	// Currency: Currency of the amount
+	Currency paystack.Currency `json:"currency"`
+
+	// Status: Status of the payment e.g. success, failed or abandoned
+	Status paystack.TransactionStatus `json:"status"`
+}
+
+// Ledger is your record of payments
+type Ledger interface {
+	// Entries returns the payments made between from and to
+	Entries(ctx context.Context, from, to time.Time) ([]Entry, error)
+}
+
+// LedgerFunc is a Ledger backed by a function
+type LedgerFunc func(ctx context.Context, from, to time.Time) ([]Entry, error)
+
+func (f LedgerFunc) Entries(ctx context.Context, from, to time.Time) ([]Entry, error) {
+	return f(ctx, from, to)
+}
+
+// Kind is the kind of a discrepancy
+type Kind string
+
+const (
+	// MissingInLedger: The transaction is on Paystack but not in the ledger
+	MissingInLedger Kind = "missing_in_ledger"
+
+	// MissingOnPaystack: The payment is in the ledger but not on Paystack
+	MissingOnPaystack Kind = "missing_on_paystack"
+
+	// AmountMismatch: The ledger and Paystack have different amounts
+	AmountMismatch Kind = "amount_mismatch"
+
+	// CurrencyMismatch: The ledger and Paystack have different currencies
+	CurrencyMismatch Kind = "currency_mismatch"
+
+	// StatusDrift: The ledger and Paystack have different statuses
+	StatusDrift Kind = "status_drift"
+)
+
+// Discrepancy is a payment the ledger and Paystack disagree about
+type Discrepancy struct {
+	Kind      Kind   `json:"kind"`
+	Reference string `json:"reference"`
+
+	// Ledger: The payment in the ledger, nil when it is missing there
+	Ledger *Entry `json:"ledger,omitempty"`
+
+	// Paystack: The transaction on Paystack, nil when it is missing there
+	Paystack *Entry `json:"paystack,omitempty"`
+}
+
+// Options controls which transactions are reconciled. Zero values use the defaults.
+type Options struct {
+	// Statuses: Only report transactions missing in the ledger when they
+	// have one of these statuses, e.g. success and reversed when the ledger
+	// does not record abandoned payments. Defaults to every status
+	Statuses []paystack.TransactionStatus
+
+	// PerPage: Number of transactions fetched per page. Defaults to 100
+	PerPage int
+}
+
+// Reconcile walks the Paystack transactions made between from and to and
+// compares them with the entries of the ledger for the same range. The
+// ledger and Paystack are matched on the transaction reference.
+func Reconcile(ctx context.Context, client *paystack.Client, ledger Ledger, from, to time.Time, opts *Options) (*Report, error) {
+	options := Options{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.PerPage <= 0 {
+		options.PerPage = defaultPerPage
+	}
+
+	entries, err := ledger.Entries(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read ledger: %w", err)
+	}
+
+	transactions, err := paystackEntries(ctx, client, from, to, options.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[paystack.TransactionStatus]bool, len(options.Statuses))
+	for _, status := range options.Statuses {
+		statuses[status] = true
+	}
+
+	report := &Report{From: from, To: to, Discrepancies: []Discrepancy{}}
+	seen := make(map[string]bool, len(entries))
+	for i := range entries {
+		entry := &entries[i]
+		seen[entry.Reference] = true
+
+		transaction, ok := transactions[entry.Reference]
+		if !ok {
+			report.add(MissingOnPaystack, entry, nil)
+			continue
+		}
+
+		matched := true
+		if entry.Amount != transaction.Amount {
+			report.add(AmountMismatch, entry, transaction)
+			matched = false
+		}
+		if entry.Currency != transaction.Currency {
+			report.add(CurrencyMismatch, entry, transaction)
+			matched = false
+		}
+		if entry.Status != transaction.Status {
+			report.add(StatusDrift, entry, transaction)
+			matched = false
+		}
+		if matched {
+			report.Matched++
+		}
+	}
+	for reference, transaction := range transactions {
+		if !seen[reference] && (len(statuses) == 0 || statuses[transaction.Status]) {
+			report.add(MissingInLedger, nil, transaction)
+		}
+	}
+
+	sort.SliceStable(report.Discrepancies, func(i, j int) bool {
+		a, b := report.Discrepancies[i], report.Discrepancies[j]
+		if a.Reference != b.Reference {
+			return a.Reference < b.Reference
+		}
+		return a.Kind < b.Kind
+	})
+	return report, nil
+}
+
+// paystackEntries walks every page of the transactions made between from
+// and to, keyed by reference
+func paystackEntries(ctx context.Context, client *paystack.Client, from, to time.Time, perPage int) (map[string]*Entry, error) {
+	client = client.WithContext(ctx)
+	entries := make(map[string]*Entry)
+	for page := 1; ; page++ {
+		transactions, err := client.Transactions.ListPage(&paystack.ListTransactionsParams{
+			ListParams: paystack.ListParams{PerPage: perPage, Page: page, From: from, To: to},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot list page %d of the transactions: %w", page, err)
+		}
+
+		for _, transaction := range transactions.Data {
+			entries[transaction.Reference] = &Entry{
+				Reference: transaction.Reference,
+				Amount:    transaction.Amount,
+				Currency:  transaction.Currency,
+				Status:    transaction.Status,
+			}
+		}
+
+		if len(transactions.Data) == 0 || page >= transactions.Meta.PageCount {
+			return entries, nil
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	paystack "github.com/rxxcc/paystack-go-sdk"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var pages = []string{
+	`{"status":true,"data":[
+		{"reference":"ref-1","amount":20000,"currency":"NGN","status":"success"},
+		{"reference":"ref-2","amount":15000,"currency":"NGN","status":"success"},
+		{"reference":"ref-3","amount":5000,"currency":"GHS","status":"reversed"}
+	],"meta":{"page":1,"pageCount":2}}`,
+	`{"status":true,"data":[
+		{"reference":"ref-4","amount":1000,"currency":"NGN","status":"success"},
+		{"reference":"ref-5","amount":1000,"currency":"NGN","status":"abandoned"}
+	],"meta":{"page":2,"pageCount":2}}`,
+}
+
+func newClient(t *testing.T, from, to time.Time) *paystack.Client {
+	client, _ := paystack.New("sk_test_xxxx")
+	client.Config.Client = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		if req.URL.Path != "/transaction" || query.Get("from") != from.Format(time.RFC3339) || query.Get("to") != to.Format(time.RFC3339) {
+			t.Errorf("unexpected request %s", req.URL)
+		}
+		page := 0
+		if query.Get("page") == "2" {
+			page = 1
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(pages[page])),
+		}, nil
+	})}
+	return client
+}
+
+func TestReconcile(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	ledger := LedgerFunc(func(ctx context.Context, gotFrom, gotTo time.Time) ([]Entry, error) {
+		if !gotFrom.Equal(from) || !gotTo.Equal(to) {
+			t.Errorf("unexpected range %s - %s", gotFrom, gotTo)
+		}
+		return []Entry{
+			{Reference: "ref-1", Amount: 20000, Currency: paystack.CurrencyNGN, Status: paystack.TransactionSuccess},
+			{Reference: "ref-2", Amount: 10000, Currency: paystack.CurrencyNGN, Status: paystack.TransactionSuccess},
+			{Reference: "ref-3", Amount: 5000, Currency: paystack.CurrencyNGN, Status: paystack.TransactionSuccess},
+			{Reference: "ref-9", Amount: 700, Currency: paystack.CurrencyNGN, Status: paystack.TransactionSuccess},
+		}, nil
+	})
+
+	report, err := Reconcile(context.Background(), newClient(t, from, to), ledger, from, to, &Options{
+		Statuses: []paystack.TransactionStatus{paystack.TransactionSuccess, paystack.TransactionReversed},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, discrepancy := range report.Discrepancies {
+		got = append(got, discrepancy.Reference+" "+string(discrepancy.Kind))
+	}
+	want := "ref-2 amount_mismatch, ref-3 currency_mismatch, ref-3 status_drift, ref-4 missing_in_ledger, ref-9 missing_on_paystack"
+	if strings.Join(got, ", ") != want {
+		t.Errorf("unexpected discrepancies\n got: %s\nwant: %s", strings.Join(got, ", "), want)
+	}
+	if report.Matched != 1 {
+		t.Errorf("expected 1 matched payment, got %d", report.Matched)
+	}
+	if report.Count(StatusDrift) != 1 {
+		t.Errorf("expected 1 status drift, got %d", report.Count(StatusDrift))
+	}
+
+	t.Run("json", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		if err := report.WriteJSON(buf); err != nil {
+			t.Fatal(err)
+		}
+		decoded := Report{}
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if len(decoded.Discrepancies) != 5 || decoded.Discrepancies[3].Ledger != nil || decoded.Discrepancies[3].Paystack.Amount != 1000 {
+			t.Errorf("unexpected report %s", buf)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		if err := report.WriteCSV(buf); err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		if len(lines) != 6 {
+			t.Fatalf("expected a header and 5 rows, got %q", buf)
+		}
+		if lines[1] != "amount_mismatch,ref-2,10000,NGN,success,15000,NGN,success" {
+			t.Errorf("unexpected row %q", lines[1])
+		}
+		if lines[4] != "missing_in_ledger,ref-4,,,,1000,NGN,success" {
+			t.Errorf("unexpected row %q", lines[4])
+		}
+	})
+}
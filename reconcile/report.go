@@ -0,0 +1,83 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Report is the outcome of a reconciliation
+type Report struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	// Matched: Number of payments the ledger and Paystack agree on
+	Matched int `json:"matched"`
+
+	// Discrepancies: Payments the ledger and Paystack disagree about, sorted by reference
+	Discrepancies []Discrepancy `json:"discrepancies"`
+}
+
+func (r *Report) add(kind Kind, ledger, paystack *Entry) {
+	reference := ""
+	if ledger != nil {
+		reference = ledger.Reference
+	} else if paystack != nil {
+		reference = paystack.Reference
+	}
+
+	r.Discrepancies = append(r.Discrepancies, Discrepancy{
+		Kind:      kind,
+		Reference: reference,
+		Ledger:    ledger,
+		Paystack:  paystack,
+	})
+}
+
+// Count returns the number of discrepancies of a kind
+func (r *Report) Count(kind Kind) int {
+	count := 0
+	for _, discrepancy := range r.Discrepancies {
+		if discrepancy.Kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+// WriteJSON writes the report as an indented JSON object
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes a row per discrepancy with both sides next to each other,
+// leaving the columns of a missing side empty
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	_ = writer.Write([]string{
+		"kind", "reference",
+		"ledger_amount", "ledger_currency", "ledger_status",
+		"paystack_amount", "paystack_currency", "paystack_status",
+	})
+
+	for _, discrepancy := range r.Discrepancies {
+		row := []string{string(discrepancy.Kind), discrepancy.Reference}
+		row = append(row, csvColumns(discrepancy.Ledger)...)
+		row = append(row, csvColumns(discrepancy.Paystack)...)
+		_ = writer.Write(row)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func csvColumns(entry *Entry) []string {
+	if entry == nil {
+		return []string{"", "", ""}
+	}
+	return []string{strconv.FormatUint(entry.Amount, 10), string(entry.Currency), string(entry.Status)}
+}
@@ -0,0 +1,166 @@
+package paystack
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// referenceAlphabet is Crockford's base32, which only uses characters
+// Paystack allows in references and sorts in the same order as the values
+const referenceAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+const defaultRandomReferenceLength = 16
+
+// ReferenceGenerator creates unique transaction references. References may
+// only contain -, ., = and alphanumeric characters.
+type ReferenceGenerator interface {
+	NewReference() (string, error)
+}
+
+// ReferenceFunc is a ReferenceGenerator backed by a function
+type ReferenceFunc func() (string, error)
+
+func (f ReferenceFunc) NewReference() (string, error) {
+	return f()
+}
+
+// RandomReference generates random alphanumeric references, e.g. 7ZK3M0QW9D1XH5TB
+type RandomReference struct {
+	// Length of the reference. Defaults to 16
+	Length int
+}
+
+func (r RandomReference) NewReference() (string, error) {
+	length := r.Length
+	if length <= 0 {
+		length = defaultRandomReferenceLength
+	}
+
+	buf := make([]byte, length)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("cannot generate reference: %w", err)
+	}
+	for i := range buf {
+		buf[i] = referenceAlphabet[buf[i]%32]
+	}
+	return string(buf), nil
+}
+
+// SortableReference generates 26 character ULID-style references that sort
+// by the time they were created, e.g. 01HQ3V5XKJ8M2C4R7T9W0YZABC. References
+// made within the same millisecond still sort in the order they were made.
+type SortableReference struct {
+	mu     sync.Mutex
+	lastMs uint64
+	last   [10]byte
+}
+
+func (r *SortableReference) NewReference() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ms := uint64(time.Now().UnixMilli())
+	if ms <= r.lastMs {
+		// bump the random part so references keep sorting within a millisecond
+		ms = r.lastMs
+		for i := len(r.last) - 1; i >= 0; i-- {
+			r.last[i]++
+			if r.last[i] != 0 {
+				break
+			}
+		}
+	} else if _, err := rand.Read(r.last[:]); err != nil {
+		return "", fmt.Errorf("cannot generate reference: %w", err)
+	}
+	r.lastMs = ms
+
+	var id [16]byte
+	binary.BigEndian.PutUint16(id[0:2], uint16(ms>>32))
+	binary.BigEndian.PutUint32(id[2:6], uint32(ms))
+	copy(id[6:], r.last[:])
+	return encodeReference(id[:], 26), nil
+}
+
+// PrefixedReference namespaces the references of another generator,
+// e.g. shop-01HQ3V5XKJ8M2C4R7T9W0YZABC
+type PrefixedReference struct {
+	// Prefix put in front of every reference, characters Paystack rejects are dropped
+	Prefix string
+
+	// Generator of the rest of the reference. Defaults to a SortableReference
+	Generator ReferenceGenerator
+
+	once sync.Once
+}
+
+func (r *PrefixedReference) NewReference() (string, error) {
+	r.once.Do(func() {
+		if r.Generator == nil {
+			r.Generator = &SortableReference{}
+		}
+	})
+
+	reference, err := r.Generator.NewReference()
+	if err != nil {
+		return "", err
+	}
+	prefix := SanitizeReference(r.Prefix)
+	if prefix == "" {
+		return reference, nil
+	}
+	return prefix + "-" + reference, nil
+}
+
+// OrderReference returns the same reference every time it is called with
+// the same namespace and order ID, so a payment retried for an order can
+// never be charged as a new transaction
+//
+//	reference := paystack.OrderReference("shop", order.ID)
+func OrderReference(namespace, orderID string) string {
+	sum := sha256.Sum256([]byte(namespace + "\x00" + orderID))
+	reference := encodeReference(sum[:], 26)
+
+	if prefix := SanitizeReference(namespace); prefix != "" {
+		return prefix + "-" + reference
+	}
+	return reference
+}
+
+// SanitizeReference drops every character Paystack does not allow in a reference
+func SanitizeReference(reference string) string {
+	return strings.Map(func(r rune) rune {
+		if isAlphanumeric(r) || r == '-' || r == '.' || r == '=' {
+			return r
+		}
+		return -1
+	}, reference)
+}
+
+// encodeReference encodes data in base32 and returns the first length characters
+func encodeReference(data []byte, length int) string {
+	var out strings.Builder
+	var buffer uint32
+	var bits uint
+	for _, b := range data {
+		buffer = buffer<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out.WriteByte(referenceAlphabet[(buffer>>bits)&31])
+		}
+	}
+	if bits > 0 {
+		out.WriteByte(referenceAlphabet[(buffer<<(5-bits))&31])
+	}
+
+	encoded := out.String()
+	if len(encoded) > length {
+		encoded = encoded[:length]
+	}
+	return encoded
+}
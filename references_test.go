@@ -0,0 +1,79 @@
+package paystack
+
+import (
+	"regexp"
+	"sort"
+	"testing"
+)
+
+var allowedReference = regexp.MustCompile(`^[A-Za-z0-9\-.=]+$`)
+
+func TestReferenceGenerators(t *testing.T) {
+	generators := map[string]ReferenceGenerator{
+		"random":   RandomReference{},
+		"sortable": &SortableReference{},
+		"prefixed": &PrefixedReference{Prefix: "shop/ng"},
+		"func":     ReferenceFunc(func() (string, error) { return "ref-1", nil }),
+	}
+
+	for name, generator := range generators {
+		t.Run(name, func(t *testing.T) {
+			reference, err := generator.NewReference()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !allowedReference.MatchString(reference) {
+				t.Errorf("reference %q has characters Paystack does not allow", reference)
+			}
+		})
+	}
+
+	t.Run("random references have the requested length", func(t *testing.T) {
+		reference, _ := RandomReference{Length: 24}.NewReference()
+		if len(reference) != 24 {
+			t.Errorf("expected 24 characters, got %q", reference)
+		}
+	})
+
+	t.Run("sortable references sort in the order they were made", func(t *testing.T) {
+		generator := &SortableReference{}
+		references := make([]string, 1000)
+		for i := range references {
+			references[i], _ = generator.NewReference()
+			if len(references[i]) != 26 {
+				t.Fatalf("expected 26 characters, got %q", references[i])
+			}
+		}
+		if !sort.StringsAreSorted(references) {
+			t.Error("expected the references to be sorted")
+		}
+		for i := 1; i < len(references); i++ {
+			if references[i] == references[i-1] {
+				t.Fatalf("duplicate reference %q", references[i])
+			}
+		}
+	})
+
+	t.Run("prefixed references drop characters Paystack rejects", func(t *testing.T) {
+		reference, _ := (&PrefixedReference{
+			Prefix:    "shop/ng",
+			Generator: ReferenceFunc(func() (string, error) { return "123", nil }),
+		}).NewReference()
+		if reference != "shopng-123" {
+			t.Errorf("unexpected reference %q", reference)
+		}
+	})
+}
+
+func TestOrderReference(t *testing.T) {
+	first := OrderReference("shop", "order 42")
+	if first != OrderReference("shop", "order 42") {
+		t.Error("expected the same order to get the same reference")
+	}
+	if first == OrderReference("shop", "order 43") || first == OrderReference("store", "order 42") {
+		t.Error("expected other orders and namespaces to get other references")
+	}
+	if !allowedReference.MatchString(first) || first[:5] != "shop-" {
+		t.Errorf("unexpected reference %q", first)
+	}
+}
@@ -0,0 +1,84 @@
+package paystack
+
+import "context"
+
+// The service interfaces are implemented by the services of Client and use
+// their method names. *Config does not implement them: its methods are
+// deprecated and will be removed, and one flat method set cannot hold the
+// List, Fetch and Create of every resource.
+
+// TransactionService is implemented by *Transactions. Depend on it instead of
+// *Transactions to swap in paystacktest.TransactionService in unit tests.
+type TransactionService interface {
+	Initialize(body *TransactionBody) (Response, error)
+	InitializeOnce(body *TransactionBody) (Response, error)
+	Verify(reference string) (Response, error)
+	Wait(ctx context.Context, reference string, opts *WaitOptions) (*Transaction, error)
+	List(params ...*ListParams) (Response, error)
+	ListPage(params *ListTransactionsParams) (*ListResponse[Transaction], error)
+	Fetch(transactionID uint64) (Response, error)
+	ChargeAuthorization(body *ChargeAuthorizationBody) (Response, error)
+	ChargeAuthorizationOnce(body *ChargeAuthorizationBody) (Response, error)
+	CheckAuthorization(body *CheckAuthorizationBody) (Response, error)
+	Timeline(referenceOrID string) (Response, error)
+	Totals() (Response, error)
+	Export() (Response, error)
+	ExportFile(params *ExportTransactionsParams) (*DataResponse[TransactionExport], error)
+	ExportRows(params *ExportTransactionsParams) (*ExportReader, error)
+	PartialDebit(body *PartialDebitBody) (Response, error)
+}
+
+// CustomerService is implemented by *Customers. Depend on it instead of
+// *Customers to swap in paystacktest.CustomerService in unit tests.
+type CustomerService interface {
+	Create(body *CreateCustomerBody) (Response, error)
+	List(params ...*ListParams) (Response, error)
+	Fetch(emailOrCode string) (Response, error)
+	Update(code string, body *UpdateCustomerBody) (Response, error)
+	Validate(code string, body *ValidateCustomerBody) (Response, error)
+	SetRiskAction(body *WhiteListOrBlacklistCustomerBody) (Response, error)
+	DeactivateAuthorization(body *DeactivateAuthorizationBody) (Response, error)
+}
+
+// PlanService is implemented by *Plans. Depend on it instead of
+// *Plans to swap in paystacktest.PlanService in unit tests.
+type PlanService interface {
+	Create(body *Plan) (Response, error)
+	List(params ...*ListParams) (Response, error)
+	Fetch(codeOrID string) (Response, error)
+	Update(codeOrID string, body *Plan) (Response, error)
+}
+
+// SubscriptionService is implemented by *Subscriptions. Depend on it instead of
+// *Subscriptions to swap in paystacktest.SubscriptionService in unit tests.
+type SubscriptionService interface {
+	Create(body *CreateSubscriptionBody) (Response, error)
+	List(params ...*ListParams) (Response, error)
+	Fetch(codeOrID string) (Response, error)
+	EmailToken(codeOrID string) (string, error)
+	Enable(body *SubscriptionBody) (Response, error)
+	EnableByCode(code string) (Response, error)
+	Disable(body *SubscriptionBody) (Response, error)
+	DisableByCode(code string) (Response, error)
+	GenerateUpdateLink(code string) (Response, error)
+	SendUpdateLink(code string) (Response, error)
+}
+
+// SplitService is implemented by *Splits. Depend on it instead of
+// *Splits to swap in paystacktest.SplitService in unit tests.
+type SplitService interface {
+	Create(body *CreateSplitBody) (Response, error)
+	List(params ...*ListParams) (Response, error)
+	Fetch(id string) (Response, error)
+	Update(id string, body *UpdateSplitBody) (Response, error)
+	AddSubaccount(id string, body *AddAndUpdateSplitSubaccountBody) (Response, error)
+	RemoveSubaccount(id string, body *RemoveSubAccountFromSplitBody) (Response, error)
+}
+
+var (
+	_ TransactionService  = (*Transactions)(nil)
+	_ CustomerService     = (*Customers)(nil)
+	_ PlanService         = (*Plans)(nil)
+	_ SubscriptionService = (*Subscriptions)(nil)
+	_ SplitService        = (*Splits)(nil)
+)
@@ -5,6 +5,7 @@ package paystack
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 type CreateSubscriptionBody struct {
@@ -17,10 +18,34 @@ type CreateSubscriptionBody struct {
 	// If customer has multiple authorizations, you can set the
 	// desired authorization you wish to use for this subscription here.
 	// If this is not supplied, the customer's most recent authorization would be used
-	Authorization string `json:"authorization"`
+	Authorization string `json:"authorization,omitempty"`
 
-	// Set the date for the first debit. (ISO 8601 format) e.g. 2017-05-16T00:30:13+01:00
-	StartDate string `json:"start_date"`
+	// Set the date for the first debit. Sent in ISO 8601 format e.g. 2017-05-16T00:30:13+01:00
+	// and left out when zero, in which case the first debit happens immediately
+	StartDate time.Time `json:"start_date"`
+}
+
+// Validate checks the customer and plan are set
+func (b *CreateSubscriptionBody) Validate() error {
+	v := &validation{}
+	v.required("customer", b.Customer)
+	v.required("plan", b.Plan)
+	return v.err()
+}
+
+// MarshalJSON sends StartDate in ISO 8601 format and omits it when it is zero
+func (b CreateSubscriptionBody) MarshalJSON() ([]byte, error) {
+	type body CreateSubscriptionBody
+
+	var startDate string
+	if !b.StartDate.IsZero() {
+		startDate = b.StartDate.Format(time.RFC3339)
+	}
+
+	return json.Marshal(struct {
+		body
+		StartDate string `json:"start_date,omitempty"`
+	}{body(b), startDate})
 }
 
 type SubscriptionBody struct {
@@ -31,128 +56,157 @@ type SubscriptionBody struct {
 	Token string `json:"token"`
 }
 
-// CreateSubscription creates a subscription on your integration.
+// Validate checks the subscription code and email token are set
+func (b *SubscriptionBody) Validate() error {
+	v := &validation{}
+	v.required("code", b.Code)
+	v.required("token", b.Token)
+	return v.err()
+}
+
+type Subscription struct {
+	ID               uint64         `json:"id"`
+	Domain           string         `json:"domain"`
+	Status           string         `json:"status"`
+	SubscriptionCode string         `json:"subscription_code"`
+	EmailToken       string         `json:"email_token"`
+	Amount           uint64         `json:"amount"`
+	CronExpression   string         `json:"cron_expression"`
+	NextPaymentDate  string         `json:"next_payment_date"`
+	OpenInvoice      string         `json:"open_invoice"`
+	CreatedAt        string         `json:"createdAt"`
+	Plan             map[string]any `json:"plan"`
+	Authorization    map[string]any `json:"authorization"`
+	Customer         map[string]any `json:"customer"`
+}
+
+// Create creates a subscription on your integration.
 //
 // Docs: https://paystack.com/docs/api/#subscription-create
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.CreateSubscription(body structs{})
-func (c *Config) CreateSubscription(body *CreateSubscriptionBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Subscriptions.Create(body structs{})
+func (s *Subscriptions) Create(body *CreateSubscriptionBody) (Response, error) {
 	path := "/subscription"
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
-// ListSubscriptions list subscription available on your integration.
+// List list subscription available on your integration.
 //
 // Docs: https://paystack.com/docs/api/#subscription-list
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.ListSubscriptions()
-func (c *Config) ListSubscriptions() (Response, error) {
-	path := "/subscription"
-
-	response, err := c.makeRequest("GET", path, nil)
+//	client, _ := paystack.New(apiKey)
+//	subscriptions, err := client.Subscriptions.List(&paystack.ListParams{PerPage: 20})
+func (s *Subscriptions) List(params ...*ListParams) (Response, error) {
+	path, err := listPath("/subscription", params)
 	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
+		return nil, err
 	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+
+	return s.config.call("GET", path, nil)
+}
+
+// Fetch get details of a subscription on your integration.
+//
+// Docs: https://paystack.com/docs/api/#subscription-fetch
+//
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Subscriptions.Fetch(codeOrID string)
+func (s *Subscriptions) Fetch(codeOrID string) (Response, error) {
+	path := fmt.Sprintf("/subscription/%s", codeOrID)
+
+	return s.config.call("GET", path, nil)
 }
 
-// FetchSubscription get details of a subscription on your integration.
+// EmailToken gets the email token of a subscription, which is
+// needed alongside the subscription code to enable or disable it
 //
 // Docs: https://paystack.com/docs/api/#subscription-fetch
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.FetchSubscription(codeOrID string)
-func (c *Config) FetchSubscription(codeOrID string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	token, err := client.Subscriptions.EmailToken(codeOrID string)
+func (s *Subscriptions) EmailToken(codeOrID string) (string, error) {
 	path := fmt.Sprintf("/subscription/%s", codeOrID)
 
-	response, err := c.makeRequest("GET", path, nil)
+	subscription := &DataResponse[Subscription]{}
+	if err := s.config.decode("GET", path, nil, subscription); err != nil {
+		return "", err
+	}
+	if subscription.Data.EmailToken == "" {
+		return "", fmt.Errorf("subscription %s has no email token", codeOrID)
+	}
+	return subscription.Data.EmailToken, nil
+}
+
+// EnableByCode looks up the email token of a subscription and enables it
+//
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Subscriptions.EnableByCode(code string)
+func (s *Subscriptions) EnableByCode(code string) (Response, error) {
+	token, err := s.EmailToken(code)
 	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
+		return nil, err
 	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.Enable(&SubscriptionBody{Code: code, Token: token})
 }
 
-// EnableSubscription enables a subscription on your integration
+// DisableByCode looks up the email token of a subscription and disables it
+//
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Subscriptions.DisableByCode(code string)
+func (s *Subscriptions) DisableByCode(code string) (Response, error) {
+	token, err := s.EmailToken(code)
+	if err != nil {
+		return nil, err
+	}
+	return s.Disable(&SubscriptionBody{Code: code, Token: token})
+}
+
+// Enable enables a subscription on your integration
 //
 // Docs: https://paystack.com/docs/api/#subscription-enable
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.EnableSubscription(body struct{})
-func (c *Config) EnableSubscription(body *SubscriptionBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Subscriptions.Enable(body struct{})
+func (s *Subscriptions) Enable(body *SubscriptionBody) (Response, error) {
 	path := "/subscription/enable"
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
-// DisableSubscription disables a subscription on your integration
+// Disable disables a subscription on your integration
 //
 // Docs: https://paystack.com/docs/api/#subscription-disable
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.DisableSubscription(body struct{})
-func (c *Config) DisableSubscription(body *SubscriptionBody) (Response, error) {
-	path := "/subscription/enable"
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Subscriptions.Disable(body struct{})
+func (s *Subscriptions) Disable(body *SubscriptionBody) (Response, error) {
+	path := "/subscription/disable"
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
-// GenerateUpdateSubscriptionLink generates a link for updating the card on a subscription
+// GenerateUpdateLink generates a link for updating the card on a subscription
 //
 // Docs: https://paystack.com/docs/api/#subscription-manage-link
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.GenerateUpdateSubscriptionLink(code string})
-func (c *Config) GenerateUpdateSubscriptionLink(code string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Subscriptions.GenerateUpdateLink(code string})
+func (s *Subscriptions) GenerateUpdateLink(code string) (Response, error) {
 	path := fmt.Sprintf("/subscription/%s/manage/link/", code)
 
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
-// SendUpdateSubscriptionLink emails a customer a link for updating the card on their subscription
+// SendUpdateLink emails a customer a link for updating the card on their subscription
 //
 // Docs: https://paystack.com/docs/api/#subscription-manage-email
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.SendUpdateSubscriptionLink(code string})
-func (c *Config) SendUpdateSubscriptionLink(code string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Subscriptions.SendUpdateLink(code string})
+func (s *Subscriptions) SendUpdateLink(code string) (Response, error) {
 	path := fmt.Sprintf("/subscription/%s/manage/email/", code)
 
-	response, err := c.makeRequest("POST", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, nil)
 }
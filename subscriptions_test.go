@@ -2,143 +2,177 @@ package paystack
 
 import (
 	"encoding/json"
-	"fmt"
+	"net/http"
 	"testing"
+	"time"
 )
 
 func TestCreateSubscription(t *testing.T) {
 	createSubscription := &CreateSubscriptionBody{
-		Customer: "",
-		Plan:     "",
+		Customer: "CUS_xnxdt6s1zg1f4nx",
+		Plan:     "PLN_gx2wn530m0i3w3m",
 	}
 
 	t.Run("create subscription", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "create_subscription")
 
 		createSub, err := client.CreateSubscription(createSubscription)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(createSub, "", "    ")
-		fmt.Println(string(data))
+		requireFields(t, responseData(t, createSub), "subscription_code", "email_token", "status")
 	})
 }
 
 func TestListSubscriptions(t *testing.T) {
 	t.Run("list subscriptions", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "list_subscriptions")
 
 		listSub, err := client.ListSubscriptions()
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(listSub, "", "    ")
-		fmt.Println(string(data))
+		if _, ok := listSub["data"].([]any); listSub["status"] != true || !ok {
+			t.Errorf("unexpected response %v", listSub)
+		}
 	})
 }
 
 func TestFetchSubscription(t *testing.T) {
 	t.Run("fetch subscription", func(t *testing.T) {
-		codeOrID := "random"
-
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		codeOrID := "SUB_vsyqdmlzble3uii"
+		client := newRecordedClient(t, "fetch_subscription")
 
 		listSub, err := client.FetchSubscription(codeOrID)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(listSub, "", "    ")
-		fmt.Println(string(data))
-
+		data := responseData(t, listSub)
+		if data["subscription_code"] != codeOrID {
+			t.Errorf("unexpected subscription %v", data)
+		}
 	})
 }
 
 func TestEnableSubscription(t *testing.T) {
 	enableSub := &SubscriptionBody{
-		Code:  "",
-		Token: "",
+		Code:  "SUB_vsyqdmlzble3uii",
+		Token: "d7gofp6yppn3qz7",
 	}
 
 	t.Run("enable subscription", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "enable_subscription")
 
 		listSub, err := client.EnableSubscription(enableSub)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(listSub, "", "    ")
-		fmt.Println(string(data))
+		if listSub["status"] != true {
+			t.Errorf("unexpected response %v", listSub)
+		}
 	})
 }
 
 func TestDisableSubscription(t *testing.T) {
 	disableSub := &SubscriptionBody{
-		Code:  "",
-		Token: "",
+		Code:  "SUB_vsyqdmlzble3uii",
+		Token: "d7gofp6yppn3qz7",
 	}
 
 	t.Run("disable subscription", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "disable_subscription")
 
 		listSub, err := client.DisableSubscription(disableSub)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(listSub, "", "    ")
-		fmt.Println(string(data))
+		if listSub["status"] != true {
+			t.Errorf("unexpected response %v", listSub)
+		}
 	})
 }
 
 func TestSendUpdateSubscriptionLink(t *testing.T) {
 	t.Run("send update subscription link", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "send_update_subscription_link")
 
-		sub, err := client.SendUpdateSubscriptionLink("random")
+		sub, err := client.SendUpdateSubscriptionLink("SUB_vsyqdmlzble3uii")
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(sub, "", "    ")
-		fmt.Println(string(data))
+		if sub["status"] != true {
+			t.Errorf("unexpected response %v", sub)
+		}
 	})
 }
 
 func TestGenerateUpdateSubscriptionLink(t *testing.T) {
 	t.Run("generate update subscription link", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
+		client := newRecordedClient(t, "generate_update_subscription_link")
+
+		sub, err := client.GenerateUpdateSubscriptionLink("SUB_vsyqdmlzble3uii")
 		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
+			t.Fatal(err)
 		}
 
-		sub, err := client.GenerateUpdateSubscriptionLink("random")
+		requireFields(t, responseData(t, sub), "link")
+	})
+}
+
+func TestCreateSubscriptionBodyStartDate(t *testing.T) {
+	t.Run("omits a zero start date", func(t *testing.T) {
+		data, err := json.Marshal(&CreateSubscriptionBody{Customer: "CUS_xnxdt6s1zg1f4nx", Plan: "PLN_gx2wn530m0i3w3m"})
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
+		}
+		if string(data) != `{"customer":"CUS_xnxdt6s1zg1f4nx","plan":"PLN_gx2wn530m0i3w3m"}` {
+			t.Errorf("unexpected body %s", data)
 		}
+	})
 
-		data, _ := json.MarshalIndent(sub, "", "    ")
-		fmt.Println(string(data))
+	t.Run("sends the start date in ISO 8601 format", func(t *testing.T) {
+		startDate := time.Date(2017, 5, 16, 0, 30, 13, 0, time.FixedZone("WAT", 3600))
+		data, err := json.Marshal(&CreateSubscriptionBody{Customer: "CUS_xnxdt6s1zg1f4nx", Plan: "PLN_gx2wn530m0i3w3m", Authorization: "AUTH_72btv547", StartDate: startDate})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"customer":"CUS_xnxdt6s1zg1f4nx","plan":"PLN_gx2wn530m0i3w3m","authorization":"AUTH_72btv547","start_date":"2017-05-16T00:30:13+01:00"}` {
+			t.Errorf("unexpected body %s", data)
+		}
+	})
+}
+
+func TestDisableSubscriptionByCode(t *testing.T) {
+	t.Run("disable subscription by code", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method + " " + r.URL.Path {
+			case "GET /subscription/SUB_vsyqdmlzble3uii":
+				_, _ = w.Write([]byte(`{"status":true,"data":{"subscription_code":"SUB_vsyqdmlzble3uii","email_token":"d7gofp6yppn3qz7","status":"active"}}`))
+			case "POST /subscription/disable":
+				var body SubscriptionBody
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				if body.Code != "SUB_vsyqdmlzble3uii" || body.Token != "d7gofp6yppn3qz7" {
+					t.Errorf("unexpected body %+v", body)
+				}
+				_, _ = w.Write([]byte(`{"status":true,"message":"Subscription disabled successfully"}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		response, err := FromConfig(client).Subscriptions.DisableByCode("SUB_vsyqdmlzble3uii")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response["message"] != "Subscription disabled successfully" {
+			t.Errorf("unexpected response %v", response)
+		}
 	})
 }
@@ -5,10 +5,8 @@ package paystack
 import (
 	"encoding/json"
 	"fmt"
-)
-
-var (
-	jsonResponse Response
+	"net/url"
+	"strconv"
 )
 
 type TransactionBody struct {
@@ -22,7 +20,7 @@ type TransactionBody struct {
 
 	// Currency: The default currency (NGN, GHS, ZAR, or USD)
 	// Defaults to your integration currency
-	Currency string `json:"currency,omitempty"`
+	Currency Currency `json:"currency,omitempty"`
 
 	// Reference: Unique transaction reference. Only -, ., =
 	// and alphanumeric characters allowed.
@@ -39,15 +37,15 @@ type TransactionBody struct {
 	// InvoiceLimit: Number of times to charge customer during subscription to plan
 	InvoiceLimit uint64 `json:"invoice_limit,omitempty"`
 
-	// Metadata: Stringified JSON object of custom data.
+	// Metadata: Custom data, sent as stringified JSON.
 	// Kindly check the Metadata page for more information.
 	// https://paystack.com/docs/payments/metadata
-	Metadata string `json:"metadata,omitempty"`
+	Metadata *Metadata `json:"metadata,omitempty"`
 
 	// Channels: An array of payment channels to control what channels
 	// you want to make available to the user to make a payment with.
 	// Available channels include: ["card", "bank", "ussd", "qr", "mobile_money", "bank_transfer", "eft"]
-	Channels []string `json:"channels,omitempty"`
+	Channels []Channel `json:"channels,omitempty"`
 
 	// SplitCode: The split code of the transaction split. e.g. SPL_98WF13Eb3w
 	SplitCode string `json:"split_code,omitempty"`
@@ -61,7 +59,7 @@ type TransactionBody struct {
 	TransactionCharge uint64 `json:"transaction_charge,omitempty"`
 
 	// Bearer: Who bears Paystack charges? account or subaccount (defaults to account).
-	Bearer string `json:"bearer,omitempty"`
+	Bearer BearerType `json:"bearer,omitempty"`
 }
 
 type ChargeAuthorizationBody struct {
@@ -80,18 +78,17 @@ type ChargeAuthorizationBody struct {
 	Reference string `json:"reference,omitempty"`
 
 	// Currency in which amount should be charged. Allowed values are: NGN, GHS, ZAR or USD
-	Currency string `json:"currency,omitempty"`
+	Currency Currency `json:"currency,omitempty"`
 
-	// Metadata: Stringified JSON object.
-	// Add a custom_fields attribute which has an array of objects
-	// if you would like the fields to be added to your transaction
-	// when displayed on the dashboard.
+	// Metadata: Custom data, sent as stringified JSON.
+	// Add custom fields with AddCustomField if you would like the
+	// fields to be added to your transaction when displayed on the dashboard.
 	// Sample: {"custom_fields":[{"display_name":"Cart ID","variable_name": "cart_id","value": "8393"}]}
-	Metadata string `json:"metadata,omitempty"`
+	Metadata *Metadata `json:"metadata,omitempty"`
 
 	// Channels: Send us 'card' or 'bank' or 'card','bank' as
 	// an array to specify what options to show the user paying
-	Channels []string `json:"channels,omitempty"`
+	Channels []Channel `json:"channels,omitempty"`
 
 	// Subaccount: The code for the subaccount that owns the payment. e.g. ACCT_8f4s1eq7ml6rlzj
 	Subaccount string `json:"subaccount,omitempty"`
@@ -105,7 +102,7 @@ type ChargeAuthorizationBody struct {
 	TransactionCharge uint64 `json:"transaction_charge,omitempty"`
 
 	// Bearer: Who bears Paystack charges? account or subaccount (defaults to account).
-	Bearer string `json:"bearer,omitempty"`
+	Bearer BearerType `json:"bearer,omitempty"`
 
 	// Queue: If you are making a scheduled charge call, it is a good idea
 	// to queue them so the processing system does not get overloaded
@@ -113,6 +110,64 @@ type ChargeAuthorizationBody struct {
 	Queue bool `json:"queue,omitempty"`
 }
 
+// Transaction is a transaction as returned by Transactions.Verify and Transactions.ListPage
+type Transaction struct {
+	ID              uint64            `json:"id"`
+	Domain          string            `json:"domain"`
+	Status          TransactionStatus `json:"status"`
+	Reference       string            `json:"reference"`
+	Amount          uint64            `json:"amount"`
+	RequestedAmount uint64            `json:"requested_amount"`
+	Currency        Currency          `json:"currency"`
+	Channel         Channel           `json:"channel"`
+	GatewayResponse string            `json:"gateway_response"`
+	Message         string            `json:"message"`
+	Fees            uint64            `json:"fees"`
+	IPAddress       string            `json:"ip_address"`
+	PaidAt          string            `json:"paid_at"`
+	CreatedAt       string            `json:"created_at"`
+	Metadata        *Metadata         `json:"metadata"`
+	Authorization   map[string]any    `json:"authorization"`
+	Customer        map[string]any    `json:"customer"`
+}
+
+type ListTransactionsParams struct {
+	// ListParams: The page, page size and time range to return
+	ListParams
+
+	// Customer: Only return the transactions of the customer with this ID
+	Customer uint64
+
+	// TerminalID: Only return the transactions made on this terminal
+	TerminalID string
+
+	// Status: Only return transactions with this status e.g. success, failed or abandoned
+	Status TransactionStatus
+
+	// Amount: Only return transactions of this amount
+	Amount uint64
+}
+
+func (p *ListTransactionsParams) values() url.Values {
+	if p == nil {
+		return url.Values{}
+	}
+	query := p.ListParams.values()
+	if p.Customer > 0 {
+		query.Set("customer", strconv.FormatUint(p.Customer, 10))
+	}
+	if p.TerminalID != "" {
+		query.Set("terminalid", p.TerminalID)
+	}
+	if p.Status != "" {
+		query.Set("status", string(p.Status))
+	}
+	if p.Amount > 0 {
+		query.Set("amount", strconv.FormatUint(p.Amount, 10))
+	}
+	return query
+}
+
 type CheckAuthorizationBody struct {
 	// Amount should be in kobo if currency is NGN, pesewas,
 	// if currency is GHS, and cents, if currency is ZAR
@@ -125,7 +180,7 @@ type CheckAuthorizationBody struct {
 	AuthorizationCode string `json:"authorization_code"`
 
 	// Currency in which amount should be charged. Allowed values are: NGN, GHS, ZAR or USD
-	Currency string `json:"currency,omitempty"`
+	Currency Currency `json:"currency,omitempty"`
 }
 
 type PartialDebitBody struct {
@@ -133,7 +188,7 @@ type PartialDebitBody struct {
 	AuthorizationCode string `json:"authorization_code"`
 
 	// Currency: Specify the currency you want to debit. Allowed values are NGN, GHS, ZAR or USD.
-	Currency string `json:"currency"`
+	Currency Currency `json:"currency"`
 
 	// Amount should be in kobo if currency is NGN, pesewas,
 	// if currency is GHS, and cents, if currency is ZAR
@@ -149,94 +204,168 @@ type PartialDebitBody struct {
 	AtLeast string `json:"at_least,omitempty"`
 }
 
-// InitializeTransaction initiate a new transaction
+// MarshalJSON sends Metadata as stringified JSON
+func (b TransactionBody) MarshalJSON() ([]byte, error) {
+	type body TransactionBody
+
+	metadata, err := b.Metadata.stringify()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		body
+		Metadata string `json:"metadata,omitempty"`
+	}{body(b), metadata})
+}
+
+// MarshalJSON sends Metadata as stringified JSON
+func (b ChargeAuthorizationBody) MarshalJSON() ([]byte, error) {
+	type body ChargeAuthorizationBody
+
+	metadata, err := b.Metadata.stringify()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		body
+		Metadata string `json:"metadata,omitempty"`
+	}{body(b), metadata})
+}
+
+// Validate checks the transaction before it is initialized. Amount may
+// be left out when a plan is provided, since the plan sets the amount.
+func (b *TransactionBody) Validate() error {
+	v := &validation{}
+	v.email("email", b.Email, true)
+	v.amount("amount", b.Amount, b.Plan == "")
+	v.currency("currency", b.Currency, false)
+	v.reference("reference", b.Reference)
+	v.url("callback_url", b.CallbackURL)
+	v.channels("channels", b.Channels)
+	if b.Bearer != "" && !b.Bearer.validTransactionBearer() {
+		v.add("bearer", "must be account or subaccount, got %q", b.Bearer)
+	}
+	return v.err()
+}
+
+// Validate checks the charge before the authorization is charged
+func (b *ChargeAuthorizationBody) Validate() error {
+	v := &validation{}
+	v.amount("amount", b.Amount, true)
+	v.email("email", b.Email, true)
+	v.required("authorization_code", b.AuthorizationCode)
+	v.reference("reference", b.Reference)
+	v.currency("currency", b.Currency, false)
+	v.channels("channels", b.Channels)
+	if b.Bearer != "" && !b.Bearer.validTransactionBearer() {
+		v.add("bearer", "must be account or subaccount, got %q", b.Bearer)
+	}
+	return v.err()
+}
+
+// Validate checks the authorization check before it is sent
+func (b *CheckAuthorizationBody) Validate() error {
+	v := &validation{}
+	v.amount("amount", b.Amount, true)
+	v.email("email", b.Email, true)
+	v.required("authorization_code", b.AuthorizationCode)
+	v.currency("currency", b.Currency, false)
+	return v.err()
+}
+
+// Validate checks the partial debit before it is sent
+func (b *PartialDebitBody) Validate() error {
+	v := &validation{}
+	v.required("authorization_code", b.AuthorizationCode)
+	v.currency("currency", b.Currency, true)
+	v.amount("amount", b.Amount, true)
+	v.email("email", b.Email, true)
+	v.reference("reference", b.Reference)
+	v.amount("at_least", b.AtLeast, false)
+	return v.err()
+}
+
+// Initialize initiate a new transaction. A reference that was already used
+// fails with ErrReferenceExists along with the *APIError.
 //
 // Docs: https://paystack.com/docs/api/#transaction-initialize
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	transaction, err := client.InitializeTransaction(body struct{})
-func (c *Config) InitializeTransaction(body *TransactionBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	transaction, err := client.Transactions.Initialize(body struct{})
+func (s *Transactions) Initialize(body *TransactionBody) (Response, error) {
 	path := "/transaction/initialize"
 
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
+	response, err := s.config.call("POST", path, body)
+	if isDuplicateReference(err) {
+		return response, fmt.Errorf("%w: %w", ErrReferenceExists, err)
 	}
-
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, nil
+	return response, err
 }
 
-// VerifyTransaction confirms the status of a transaction
+// Verify confirms the status of a transaction
 //
 // Docs: https://paystack.com/docs/api/#transaction-verify
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	transaction, err := client.VerifyTransaction(reference string)
-func (c *Config) VerifyTransaction(reference string) (Response, error) {
-	path := fmt.Sprintf("/transaction/verify/%s", reference)
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+//	client, _ := paystack.New(apiKey)
+//	transaction, err := client.Transactions.Verify(reference string)
+func (s *Transactions) Verify(reference string) (Response, error) {
+	path := fmt.Sprintf("/transaction/verify/%s", url.PathEscape(reference))
+	return s.config.call("GET", path, nil)
 }
 
-// ListTransactions returns the transactions carried out on your integration
+// List returns the transactions carried out on your integration
 //
 // Docs: https://paystack.com/docs/api/#transaction-list
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	transaction, err := client.ListTransaction()
-func (c *Config) ListTransactions() (Response, error) {
-	path := "/transaction"
-	response, err := c.makeRequest("GET", path, nil)
+//	client, _ := paystack.New(apiKey)
+//	transactions, err := client.Transactions.List(&paystack.ListParams{PerPage: 20})
+func (s *Transactions) List(params ...*ListParams) (Response, error) {
+	path, err := listPath("/transaction", params)
 	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
+		return nil, err
 	}
+	return s.config.call("GET", path, nil)
+}
 
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+// ListPage returns a page of the transactions carried out on
+// your integration, filtered by params
+//
+// Docs: https://paystack.com/docs/api/#transaction-list
+//
+//	client, _ := paystack.New(apiKey)
+//	transactions, err := client.Transactions.ListPage(&paystack.ListTransactionsParams{Status: paystack.TransactionSuccess})
+func (s *Transactions) ListPage(params *ListTransactionsParams) (*ListResponse[Transaction], error) {
+	path := withQuery("/transaction", params.values())
+
+	transactions := &ListResponse[Transaction]{}
+	if err := s.config.decode("GET", path, nil, transactions); err != nil {
+		return nil, err
+	}
+	return transactions, nil
 }
 
-// FetchTransaction gets details of a transactionn carried out on your integration
+// Fetch gets details of a transactionn carried out on your integration
 //
 // Docs: https://paystack.com/docs/api/#transaction-fetch
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	transaction, err := client.FetchTransaction(transactionID uint64)
-func (c *Config) FetchTransaction(transactionID uint64) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	transaction, err := client.Transactions.Fetch(transactionID uint64)
+func (s *Transactions) Fetch(transactionID uint64) (Response, error) {
 	path := fmt.Sprintf("/transaction/%d", transactionID)
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
 // ChargeAuthorization - All authorizations marked as reusable can be charged with this endpoint whenever you need to receive payments.
 //
 // Docs: https://paystack.com/docs/api/#transaction-charge-authorization
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.ChargeAuthorization(body *ChargeAuthorizationBody struct{})
-func (c *Config) ChargeAuthorization(body *ChargeAuthorizationBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Transactions.ChargeAuthorization(body *ChargeAuthorizationBody struct{})
+func (s *Transactions) ChargeAuthorization(body *ChargeAuthorizationBody) (Response, error) {
 	path := "/transaction/charge_authorization"
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
 // CheckAuthorization:
@@ -254,85 +383,55 @@ func (c *Config) ChargeAuthorization(body *ChargeAuthorizationBody) (Response, e
 //
 // Docs: https://paystack.com/docs/api/#transaction-check-authorization
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.CheckAuthorization(body *CheckAuthorizationBody struct{})
-func (c *Config) CheckAuthorization(body *CheckAuthorizationBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Transactions.CheckAuthorization(body *CheckAuthorizationBody struct{})
+func (s *Transactions) CheckAuthorization(body *CheckAuthorizationBody) (Response, error) {
 	path := "/transaction/check_authorization"
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
-// ViewTransactionTimeLine views the timeline of a transaction
+// Timeline views the timeline of a transaction
 //
 // Docs: https://paystack.com/docs/api/#transaction-view-timeline
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.ViewTransactionTimeLine(referenceOrID string)
-func (c *Config) ViewTransactionTimeLine(referenceOrID string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Transactions.Timeline(referenceOrID string)
+func (s *Transactions) Timeline(referenceOrID string) (Response, error) {
 	path := fmt.Sprintf("/transaction/timeline/%s", referenceOrID)
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
-// TransactionTotals returns the total amount received on your account
+// Totals returns the total amount received on your account
 //
 // Docs: https://paystack.com/docs/api/#transaction-totals
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.TransactionTotals()
-func (c *Config) TransactionTotals() (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Transactions.Totals()
+func (s *Transactions) Totals() (Response, error) {
 	path := "/transaction/totals"
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
-// ExportTransactions lists out transactions carried out on your integration
-// and export them to a csv file.
+// Export lists out transactions carried out on your integration
+// and export them to a csv file. Use ExportRows to filter the
+// export and read its rows.
 //
 // Docs: https://paystack.com/docs/api/#transaction-export
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.ExportTransactions()
-func (c *Config) ExportTransactions() (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Transactions.Export()
+func (s *Transactions) Export() (Response, error) {
 	path := "/transaction/export"
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
 // PartialDebit retrieves part of a payment from a customer
 //
 // Docs: https://paystack.com/docs/api/#transaction-partial-debit
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.PartialDebit(body struct{}})
-func (c *Config) PartialDebit(body *PartialDebitBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Transactions.PartialDebit(body struct{}})
+func (s *Transactions) PartialDebit(body *PartialDebitBody) (Response, error) {
 	path := "/transaction/partial_debit"
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
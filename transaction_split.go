@@ -4,7 +4,6 @@
 package paystack
 
 import (
-	"encoding/json"
 	"fmt"
 )
 
@@ -14,17 +13,17 @@ type CreateSplitBody struct {
 
 	// Type: The type of transaction split you want to create.
 	// You can use one of the following: percentage | flat
-	Type string `json:"type"`
+	Type SplitType `json:"type"`
 
 	// Currency: Any of NGN, GHS, ZAR, or USD
-	Currency string `json:"currency"`
+	Currency Currency `json:"currency"`
 
 	// Subaccounts: A list of object containing subaccount code
 	// and number of shares: [{subaccount: ‘ACT_xxxxxxxxxx’, share: xxx},{...}]
-	Subaccounts []map[string]any
+	Subaccounts []map[string]any `json:"subaccounts"`
 
 	// BearerType: Any of subaccount | account | all-proportional | all
-	BearerType string `json:"bearer_type"`
+	BearerType BearerType `json:"bearer_type"`
 
 	// BearerSubAccount: Subaccount code
 	BearerSubAccount string `json:"bearer_subaccount"`
@@ -37,12 +36,54 @@ type UpdateSplitBody struct {
 	// Active: True or False
 	Active bool `json:"active"`
 
-	// Any of the following values: subaccount | account | all-proportional | all
-	BearerType string `json:"bearer_type"`
+	// Any of the following values: subaccount | account | all-proportional | all.
+	// Left out when empty, so the current bearer is kept
+	BearerType BearerType `json:"bearer_type,omitempty"`
 
 	// BearerSubAccount: Subaccount code of a subaccount in the split group.
 	// This should be specified only if the bearer_type is subaccount
-	BearerSubAccount string `json:"bearer_subaccount"`
+	BearerSubAccount string `json:"bearer_subaccount,omitempty"`
+}
+
+// Validate checks the split before it is created
+func (b *CreateSplitBody) Validate() error {
+	v := &validation{}
+	v.required("name", b.Name)
+	if b.Type == "" {
+		v.add("type", "is required")
+	} else if !b.Type.Valid() {
+		v.add("type", "must be percentage or flat, got %q", b.Type)
+	}
+	v.currency("currency", b.Currency, true)
+	if len(b.Subaccounts) == 0 {
+		v.add("subaccounts", "must contain at least one subaccount")
+	}
+	for i, subaccount := range b.Subaccounts {
+		if code, _ := subaccount["subaccount"].(string); code == "" {
+			v.add(fmt.Sprintf("subaccounts[%d].subaccount", i), "is required")
+		}
+		if _, ok := subaccount["share"]; !ok {
+			v.add(fmt.Sprintf("subaccounts[%d].share", i), "is required")
+		}
+	}
+	validateBearer(v, b.BearerType, b.BearerSubAccount)
+	return v.err()
+}
+
+// Validate checks the split update before it is sent
+func (b *UpdateSplitBody) Validate() error {
+	v := &validation{}
+	validateBearer(v, b.BearerType, b.BearerSubAccount)
+	return v.err()
+}
+
+func validateBearer(v *validation, bearerType BearerType, bearerSubaccount string) {
+	if bearerType != "" && !bearerType.Valid() {
+		v.add("bearer_type", "has an unsupported bearer type %q", bearerType)
+	}
+	if bearerType == BearerSubaccount && bearerSubaccount == "" {
+		v.add("bearer_subaccount", "is required when bearer_type is subaccount")
+	}
 }
 
 type AddAndUpdateSplitSubaccountBody struct {
@@ -53,113 +94,94 @@ type AddAndUpdateSplitSubaccountBody struct {
 	Share uint64 `json:"share"`
 }
 
+// Validate checks the subaccount and share are set
+func (b *AddAndUpdateSplitSubaccountBody) Validate() error {
+	v := &validation{}
+	v.required("subaccount", b.Subaccount)
+	if b.Share == 0 {
+		v.add("share", "is required")
+	}
+	return v.err()
+}
+
 type RemoveSubAccountFromSplitBody struct {
 	// Subaccount This is the sub account code
 	Subaccount string `json:"subaccount"`
 }
 
-// CreateSplit create a split payment on your integration
+// Validate checks the subaccount is set
+func (b *RemoveSubAccountFromSplitBody) Validate() error {
+	v := &validation{}
+	v.required("subaccount", b.Subaccount)
+	return v.err()
+}
+
+// Create create a split payment on your integration
 //
 // Docs: https://paystack.com/docs/api/#split-create
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.CreateSplit(body struct{}})
-func (c *Config) CreateSplit(body *CreateSplitBody) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Splits.Create(body struct{}})
+func (s *Splits) Create(body *CreateSplitBody) (Response, error) {
 	path := "/split"
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
-// ListAndSearchSplits: list/search for the transaction splits available on your integration.
+// List: list/search for the transaction splits available on your integration.
 //
 // Docs: https://paystack.com/docs/api/#split-list
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.ListAndSearchSplits()
-func (c *Config) ListAndSearchSplits() (Response, error) {
-	path := "/split"
-	response, err := c.makeRequest("GET", path, nil)
+//	client, _ := paystack.New(apiKey)
+//	splits, err := client.Splits.List(&paystack.ListParams{PerPage: 20})
+func (s *Splits) List(params ...*ListParams) (Response, error) {
+	path, err := listPath("/split", params)
 	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
+		return nil, err
 	}
-
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
-// FetchSplit: Get details of a split on your integration.
-
+// Fetch: Get details of a split on your integration.
+//
 // Docs: https://paystack.com/docs/api/#split-fetch
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.FetchSplit(id string)
-func (c *Config) FetchSplit(id string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Splits.Fetch(id string)
+func (s *Splits) Fetch(id string) (Response, error) {
 	path := fmt.Sprintf("/split/%s", id)
-	response, err := c.makeRequest("GET", path, nil)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("GET", path, nil)
 }
 
-// UpdateSplit: Update a transaction split details on your integration
+// Update: Update a transaction split details on your integration
 //
 // Docs: https://paystack.com/docs/api/#split-update
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.UpdateSplit(body struct{}, id string)
-func (c *Config) UpdateSplit(body *UpdateSplitBody, id string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Splits.Update(id string, body struct{})
+func (s *Splits) Update(id string, body *UpdateSplitBody) (Response, error) {
 	path := fmt.Sprintf("/split/%s", id)
-	response, err := c.makeRequest("PUT", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("PUT", path, body)
 }
 
-// AddAndUpdateSplitSubaccount: Add a Subaccount to a Transaction Split,
+// AddSubaccount: Add a Subaccount to a Transaction Split,
 // or update the share of an existing Subaccount in a Transaction Split
 //
 // Docs: https://paystack.com/docs/api/#split-add-subaccount
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.AddAndUpdateSplitSubaccount(body struct{}, id string)
-func (c *Config) AddAndUpdateSplitSubaccount(body *AddAndUpdateSplitSubaccountBody, id string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Splits.AddSubaccount(id string, body struct{})
+func (s *Splits) AddSubaccount(id string, body *AddAndUpdateSplitSubaccountBody) (Response, error) {
 	path := fmt.Sprintf("/split/%s/subaccount/add", id)
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
 
-// RemoveSubAccountFromSplit: Remove a subaccount from a transaction split
+// RemoveSubaccount: Remove a subaccount from a transaction split
 //
 // Docs: https://paystack.com/docs/api/#split-remove-subaccount
 //
-//	client, _ := paystack.NewClient(apiKey)
-//	auth, err := client.RemoveSubAccountFromSplit(body struct{}, id string)
-func (c *Config) RemoveSubAccountFromSplit(body *RemoveSubAccountFromSplitBody, id string) (Response, error) {
+//	client, _ := paystack.New(apiKey)
+//	auth, err := client.Splits.RemoveSubaccount(id string, body struct{})
+func (s *Splits) RemoveSubaccount(id string, body *RemoveSubAccountFromSplitBody) (Response, error) {
 	path := fmt.Sprintf("/split/%s/subaccount/remove", id)
-	response, err := c.makeRequest("POST", path, body)
-	if err != nil {
-		_ = json.Unmarshal(response, &jsonResponse)
-		return jsonResponse, err
-	}
-	_ = json.Unmarshal(response, &jsonResponse)
-	return jsonResponse, err
+	return s.config.call("POST", path, body)
 }
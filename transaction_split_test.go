@@ -2,60 +2,82 @@ package paystack
 
 import (
 	"encoding/json"
-	"fmt"
 	"testing"
 )
 
+func TestCreateSplitBodyJSON(t *testing.T) {
+	body := &CreateSplitBody{
+		Name:        "Percentage Split",
+		Type:        SplitTypePercentage,
+		Currency:    CurrencyNGN,
+		Subaccounts: []map[string]any{{"subaccount": "ACCT_z3x6z3nbo14xsil", "share": 20}},
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fields["subaccounts"]; !ok {
+		t.Errorf("expected the subaccounts to be sent as subaccounts, got %s", data)
+	}
+}
+
 func TestCreateSplit(t *testing.T) {
-	testCase := &CreateSplitBody{}
+	testCase := &CreateSplitBody{
+		Name:        "Percentage Split",
+		Type:        SplitTypePercentage,
+		Currency:    CurrencyNGN,
+		Subaccounts: []map[string]any{{"subaccount": "ACCT_8f4s1eq7ml6rlzj", "share": 20}},
+	}
 
 	t.Run("create split", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "create_split")
 
 		response, err := client.CreateSplit(testCase)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+		data := responseData(t, response)
+		requireFields(t, data, "id", "split_code", "subaccounts")
+		if data["type"] != "percentage" || data["currency"] != "NGN" {
+			t.Errorf("unexpected split %v", data)
+		}
 	})
 }
 
 func TestListAndSearchSplits(t *testing.T) {
 	t.Run("list and search splits", func(t *testing.T) {
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "list_splits")
 
 		response, err := client.ListAndSearchSplits()
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+		if _, ok := response["data"].([]any); response["status"] != true || !ok {
+			t.Errorf("unexpected response %v", response)
+		}
 	})
 }
 
 func TestFetchSplit(t *testing.T) {
 	t.Run("fetch split", func(t *testing.T) {
 		query := "143"
-		client, err := NewClient(ApiKey)
-		if err != nil {
-			t.Errorf("cannot initialize client %s", err)
-		}
+		client := newRecordedClient(t, "fetch_split")
 
 		response, err := client.FetchSplit(query)
 		if err != nil {
-			t.Error(err)
+			t.Fatal(err)
 		}
 
-		data, _ := json.MarshalIndent(response, "", "    ")
-		fmt.Println(string(data))
+		data := responseData(t, response)
+		if data["id"] != float64(143) {
+			t.Errorf("unexpected split %v", data)
+		}
 	})
 }
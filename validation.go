@@ -0,0 +1,176 @@
+package paystack
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// errNilBody is returned when a nil request body is passed to an endpoint that requires one
+var errNilBody = errors.New("request body is required")
+
+// FieldError describes a problem with a single field of a request body.
+// Field is the JSON name of the field as sent to Paystack.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+// ValidationError is returned before a request is sent when its body
+// is invalid. It lists every problem found, not just the first one.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		problems[i] = fieldErr.Error()
+	}
+	return "invalid request: " + strings.Join(problems, "; ")
+}
+
+// validator is implemented by request bodies that can check
+// themselves before they are sent to Paystack
+type validator interface {
+	Validate() error
+}
+
+// validate runs the Validate method of body, if it has one
+func validate(body any) error {
+	v, ok := body.(validator)
+	if !ok {
+		return nil
+	}
+	if value := reflect.ValueOf(body); value.Kind() == reflect.Pointer && value.IsNil() {
+		return errNilBody
+	}
+	return v.Validate()
+}
+
+// validation collects field errors while a body is being checked
+type validation struct {
+	errors []FieldError
+}
+
+func (v *validation) add(field, format string, args ...any) {
+	v.errors = append(v.errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns the collected field errors as a *ValidationError, or nil if there are none
+func (v *validation) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errors}
+}
+
+func (v *validation) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "is required")
+	}
+}
+
+func (v *validation) email(field, value string, required bool) {
+	if value == "" {
+		if required {
+			v.add(field, "is required")
+		}
+		return
+	}
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Address != value {
+		v.add(field, "must be a valid email address")
+	}
+}
+
+// amount checks that value is a whole number of the currency's
+// subunit (kobo, pesewas or cents) greater than zero
+func (v *validation) amount(field, value string, required bool) {
+	if value == "" {
+		if required {
+			v.add(field, "is required")
+		}
+		return
+	}
+	if !isDigits(value) {
+		v.add(field, "must be a whole number in the currency's subunit, got %q", value)
+		return
+	}
+	if strings.TrimLeft(value, "0") == "" {
+		v.add(field, "must be greater than zero")
+	}
+}
+
+// reference checks that value only contains -, ., = and alphanumeric characters
+func (v *validation) reference(field, value string) {
+	for _, r := range value {
+		if !isAlphanumeric(r) && r != '-' && r != '.' && r != '=' {
+			v.add(field, "may only contain -, ., = and alphanumeric characters, got %q", value)
+			return
+		}
+	}
+}
+
+func (v *validation) url(field, value string) {
+	if value == "" {
+		return
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		v.add(field, "must be a fully qualified url, got %q", value)
+	}
+}
+
+func (v *validation) digits(field, value string, length int) {
+	if !isDigits(value) || (length > 0 && len(value) != length) {
+		if length > 0 {
+			v.add(field, "must be %d digits", length)
+			return
+		}
+		v.add(field, "must only contain digits")
+	}
+}
+
+func (v *validation) currency(field string, value Currency, required bool) {
+	if value == "" {
+		if required {
+			v.add(field, "is required")
+		}
+		return
+	}
+	if !value.Valid() {
+		v.add(field, "has an unsupported currency %q", value)
+	}
+}
+
+func (v *validation) channels(field string, values []Channel) {
+	for _, channel := range values {
+		if !channel.Valid() {
+			v.add(field, "has an unsupported channel %q", channel)
+		}
+	}
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
@@ -0,0 +1,114 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		body   validator
+		fields []string
+	}{
+		{
+			name:   "valid transaction",
+			body:   &TransactionBody{Amount: "30000", Email: "test@test.com", Reference: "order-1.2=3", Currency: CurrencyNGN},
+			fields: nil,
+		},
+		{
+			name:   "transaction on a plan needs no amount",
+			body:   &TransactionBody{Email: "test@test.com", Plan: "PLN_gx2wn530m0i3w3m"},
+			fields: nil,
+		},
+		{
+			name:   "invalid transaction",
+			body:   &TransactionBody{Amount: "300.50", Email: "not-an-email", Reference: "order #1", CallbackURL: "/callback", Channels: []Channel{"cash"}},
+			fields: []string{"email", "amount", "reference", "callback_url", "channels"},
+		},
+		{
+			name:   "empty transaction",
+			body:   &TransactionBody{},
+			fields: []string{"email", "amount"},
+		},
+		{
+			name:   "charge authorization",
+			body:   &ChargeAuthorizationBody{Amount: "0", Email: "test@test.com"},
+			fields: []string{"amount", "authorization_code"},
+		},
+		{
+			name:   "partial debit",
+			body:   &PartialDebitBody{AuthorizationCode: "AUTH_72btv547", Amount: "20000", Email: "test@test.com", AtLeast: "abc"},
+			fields: []string{"currency", "at_least"},
+		},
+		{
+			name:   "create subscription",
+			body:   &CreateSubscriptionBody{Customer: "CUS_xnxdt6s1zg1f4nx"},
+			fields: []string{"plan"},
+		},
+		{
+			name:   "create split",
+			body:   &CreateSplitBody{Name: "Halfsies", Type: SplitTypePercentage, Currency: CurrencyNGN, Subaccounts: []map[string]any{{"share": 20}}, BearerType: BearerSubaccount},
+			fields: []string{"subaccounts[0].subaccount", "bearer_subaccount"},
+		},
+		{
+			name:   "validate customer",
+			body:   &ValidateCustomerBody{FirstName: "Asta", LastName: "Lavista", Type: "bank_account", Value: "0123456789", Country: "NG", BVN: "2001234567", AccountNumber: "01234x"},
+			fields: []string{"bvn", "bank_code", "account_number"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.body.Validate()
+			if tc.fields == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a ValidationError, got %v", err)
+			}
+			if len(validationErr.Errors) != len(tc.fields) {
+				t.Fatalf("expected errors for %v, got %v", tc.fields, validationErr)
+			}
+			for i, field := range tc.fields {
+				if validationErr.Errors[i].Field != field {
+					t.Errorf("expected an error for %s, got %s", field, validationErr.Errors[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateBeforeRequest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, err := client.InitializeTransaction(&TransactionBody{Amount: "3OO", Email: "test@test.com"})
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("expected a ValidationError, got %v", err)
+		}
+	})
+
+	t.Run("nil body", func(t *testing.T) {
+		if _, err := client.ChargeAuthorization(nil); !errors.Is(err, errNilBody) {
+			t.Errorf("expected errNilBody, got %v", err)
+		}
+	})
+
+	t.Run("create plan requires name, amount and interval", func(t *testing.T) {
+		_, err := client.CreatePlan(&Plan{Description: "Monthly plan"})
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || len(validationErr.Errors) != 3 {
+			t.Errorf("expected 3 field errors, got %v", err)
+		}
+	})
+}
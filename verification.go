@@ -0,0 +1,132 @@
+// The Verification API allows you perform KYC processes.
+
+package paystack
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type ResolvedAccount struct {
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+	BankID        uint64 `json:"bank_id"`
+}
+
+type ValidateAccountBody struct {
+	// AccountName: Customer's first and last name registered with their bank
+	AccountName string `json:"account_name"`
+
+	// AccountNumber: Customer's account number
+	AccountNumber string `json:"account_number"`
+
+	// AccountType: This can take one of: [ personal, business ]
+	AccountType string `json:"account_type"`
+
+	// BankCode: The bank code of the customer's bank. You can fetch the bank codes
+	// by using the List Banks endpoint
+	BankCode string `json:"bank_code"`
+
+	// CountryCode: The two digit ISO code of the customer's bank
+	CountryCode string `json:"country_code"`
+
+	// DocumentType: Customer's mode of identity.
+	// This could be one of: [ identityNumber, passportNumber, businessRegistrationNumber ]
+	DocumentType string `json:"document_type"`
+
+	// DocumentNumber: Customer's mode of identity number
+	DocumentNumber string `json:"document_number,omitempty"`
+}
+
+// Validate checks the account details before they are sent
+func (b *ValidateAccountBody) Validate() error {
+	v := &validation{}
+	v.required("account_name", b.AccountName)
+	if b.AccountNumber == "" {
+		v.add("account_number", "is required")
+	} else {
+		v.digits("account_number", b.AccountNumber, 0)
+	}
+	if b.AccountType != "personal" && b.AccountType != "business" {
+		v.add("account_type", "must be personal or business, got %q", b.AccountType)
+	}
+	v.required("bank_code", b.BankCode)
+	if len(b.CountryCode) != 2 {
+		v.add("country_code", "must be a 2 letter country code")
+	}
+	switch b.DocumentType {
+	case "identityNumber", "passportNumber", "businessRegistrationNumber":
+	default:
+		v.add("document_type", "must be one of identityNumber, passportNumber or businessRegistrationNumber, got %q", b.DocumentType)
+	}
+	return v.err()
+}
+
+type ValidatedAccount struct {
+	Verified            bool   `json:"verified"`
+	VerificationMessage string `json:"verificationMessage"`
+}
+
+type CardBIN struct {
+	BIN          string `json:"bin"`
+	Brand        string `json:"brand"`
+	SubBrand     string `json:"sub_brand"`
+	CountryCode  string `json:"country_code"`
+	CountryName  string `json:"country_name"`
+	CardType     string `json:"card_type"`
+	Bank         string `json:"bank"`
+	LinkedBankID uint64 `json:"linked_bank_id"`
+}
+
+// ResolveAccountNumber confirms an account belongs to the right customer
+//
+// Docs: https://paystack.com/docs/api/#verification-resolve-account
+//
+//	client, _ := paystack.New(apiKey)
+//	account, err := client.Verification.ResolveAccountNumber(accountNumber string, bankCode string)
+func (s *Verification) ResolveAccountNumber(accountNumber, bankCode string) (*DataResponse[ResolvedAccount], error) {
+	path := withQuery("/bank/resolve", url.Values{
+		"account_number": {accountNumber},
+		"bank_code":      {bankCode},
+	})
+
+	account := &DataResponse[ResolvedAccount]{}
+	if err := s.config.decode("GET", path, nil, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// ValidateAccount confirms the authenticity of a customer's account number
+// before sending money. This is only available for South African accounts.
+//
+// Docs: https://paystack.com/docs/api/#verification-validate-account
+//
+//	client, _ := paystack.New(apiKey)
+//	account, err := client.Verification.ValidateAccount(body struct{})
+func (s *Verification) ValidateAccount(body *ValidateAccountBody) (*DataResponse[ValidatedAccount], error) {
+	path := "/bank/validate"
+
+	account := &DataResponse[ValidatedAccount]{}
+	if err := s.config.decode("POST", path, body, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// ResolveCardBIN gets more information about a customer's card
+// using the first 6 characters of the card
+//
+// Docs: https://paystack.com/docs/api/#verification-resolve-card
+//
+//	client, _ := paystack.New(apiKey)
+//	card, err := client.Verification.ResolveCardBIN(bin string)
+func (s *Verification) ResolveCardBIN(bin string) (*DataResponse[CardBIN], error) {
+	path := fmt.Sprintf("/decision/bin/%s", url.PathEscape(bin))
+
+	card := &DataResponse[CardBIN]{}
+	if err := s.config.decode("GET", path, nil, card); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
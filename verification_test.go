@@ -0,0 +1,91 @@
+package paystack
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestResolveAccountNumber(t *testing.T) {
+	t.Run("resolve account number", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			if r.URL.Path != "/bank/resolve" || query.Get("account_number") != "0001234567" || query.Get("bank_code") != "058" {
+				t.Errorf("unexpected url %s", r.URL)
+			}
+			_, _ = w.Write([]byte(`{"status":true,"message":"Account number resolved","data":{"account_number":"0001234567","account_name":"Doe Jane Loren","bank_id":9}}`))
+		})
+
+		account, err := FromConfig(client).Verification.ResolveAccountNumber("0001234567", "058")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if account.Data.AccountName != "Doe Jane Loren" || account.Data.BankID != 9 {
+			t.Errorf("unexpected account %+v", account.Data)
+		}
+	})
+
+	t.Run("unresolvable account number", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Could not resolve account name. Check parameters or try again."}`))
+		})
+
+		_, err := FromConfig(client).Verification.ResolveAccountNumber("0000000000", "058")
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+			t.Errorf("expected an APIError, got %v", err)
+		}
+	})
+}
+
+func TestValidateAccount(t *testing.T) {
+	validateAccount := &ValidateAccountBody{
+		AccountName:    "Ann Bron",
+		AccountNumber:  "0123456789",
+		AccountType:    "personal",
+		BankCode:       "632005",
+		CountryCode:    "ZA",
+		DocumentType:   "identityNumber",
+		DocumentNumber: "1234567890123",
+	}
+
+	t.Run("validate account", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body ValidateAccountBody
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if r.Method != "POST" || r.URL.Path != "/bank/validate" || body != *validateAccount {
+				t.Errorf("unexpected request %s %s %+v", r.Method, r.URL, body)
+			}
+			_, _ = w.Write([]byte(`{"status":true,"message":"Personal Account Verification attempted","data":{"verified":true,"verificationMessage":"Account is verified successfully"}}`))
+		})
+
+		account, err := FromConfig(client).Verification.ValidateAccount(validateAccount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !account.Data.Verified {
+			t.Errorf("unexpected account %+v", account.Data)
+		}
+	})
+}
+
+func TestResolveCardBIN(t *testing.T) {
+	t.Run("resolve card bin", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/decision/bin/539983" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			_, _ = w.Write([]byte(`{"status":true,"message":"Bin resolved","data":{"bin":"539983","brand":"Mastercard","sub_brand":"","country_code":"NG","country_name":"Nigeria","card_type":"DEBIT","bank":"Guaranty Trust Bank","linked_bank_id":9}}`))
+		})
+
+		card, err := FromConfig(client).Verification.ResolveCardBIN("539983")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if card.Data.Brand != "Mastercard" || card.Data.LinkedBankID != 9 {
+			t.Errorf("unexpected card %+v", card.Data)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultWaitInterval    = 2 * time.Second
+	defaultWaitMaxInterval = 30 * time.Second
+)
+
+// WaitOptions controls how Transactions.Wait polls. Zero values use the defaults.
+type WaitOptions struct {
+	// Interval: Wait before the first check is repeated, doubled after every
+	// check. Defaults to 2 seconds
+	Interval time.Duration
+
+	// MaxInterval: Longest wait between checks. Defaults to 30 seconds
+	MaxInterval time.Duration
+
+	// OnStatus: Called whenever a check finds the transaction in a status
+	// that is not final and differs from the one found by the previous check
+	OnStatus func(transaction *Transaction)
+}
+
+// Wait verifies a transaction until it reaches a final status
+// (success, failed, abandoned or reversed) or ctx is done. Bank transfer and
+// USSD payments are often still ongoing, pending or processing when the
+// customer is redirected back.
+//
+// When ctx is done first, the transaction as last verified is returned with the error of ctx.
+//
+// Docs: https://paystack.com/docs/api/transaction/#verify
+//
+//	client, _ := paystack.New(apiKey)
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+//	defer cancel()
+//	transaction, err := client.Transactions.Wait(ctx, reference, &paystack.WaitOptions{
+//		OnStatus: func(transaction *paystack.Transaction) {
+//			log.Printf("transaction %s is %s", transaction.Reference, transaction.Status)
+//		},
+//	})
+func (s *Transactions) Wait(ctx context.Context, reference string, opts *WaitOptions) (*Transaction, error) {
+	options := WaitOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.Interval <= 0 {
+		options.Interval = defaultWaitInterval
+	}
+	if options.MaxInterval <= 0 {
+		options.MaxInterval = defaultWaitMaxInterval
+	}
+
+	client := s.config.WithContext(ctx)
+	interval := options.Interval
+	var last *Transaction
+	for {
+		transaction, err := client.verifyTransaction(reference)
+		switch {
+		case err == nil:
+			if transaction.Status.Final() {
+				return transaction, nil
+			}
+			if options.OnStatus != nil && (last == nil || last.Status != transaction.Status) {
+				options.OnStatus(transaction)
+			}
+			last = transaction
+		case ctx.Err() != nil:
+			return last, waitError(reference, last, ctx.Err())
+		case !outcomeUnknown(err):
+			return last, err
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return last, waitError(reference, last, err)
+		}
+		interval *= 2
+		if interval > options.MaxInterval {
+			interval = options.MaxInterval
+		}
+	}
+}
+
+// verifyTransaction is Transactions.Verify decoded into a Transaction
+func (c *Config) verifyTransaction(reference string) (*Transaction, error) {
+	path := fmt.Sprintf("/transaction/verify/%s", url.PathEscape(reference))
+
+	response := &DataResponse[Transaction]{}
+	if err := c.decode("GET", path, nil, response); err != nil {
+		return nil, err
+	}
+	return &response.Data, nil
+}
+
+func waitError(reference string, last *Transaction, err error) error {
+	if last == nil {
+		return fmt.Errorf("cannot verify transaction %s: %w", reference, err)
+	}
+	return fmt.Errorf("transaction %s is still %s: %w", reference, last.Status, err)
+}
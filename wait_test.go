@@ -0,0 +1,109 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWaitForTransaction(t *testing.T) {
+	t.Run("polls until a final status", func(t *testing.T) {
+		statuses := []string{"ongoing", "ongoing", "processing", "success"}
+		calls := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/transaction/verify/order-42" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			status := statuses[calls]
+			calls++
+			_, _ = w.Write([]byte(`{"status":true,"data":{"reference":"order-42","amount":20000,"status":"` + status + `","metadata":"{\"order_id\":42}"}}`))
+		})
+
+		var seen []TransactionStatus
+		transaction, err := FromConfig(client).Transactions.Wait(context.Background(), "order-42", &WaitOptions{
+			Interval: time.Millisecond,
+			OnStatus: func(transaction *Transaction) {
+				seen = append(seen, transaction.Status)
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if transaction.Status != TransactionSuccess || transaction.Amount != 20000 {
+			t.Errorf("unexpected transaction %+v", transaction)
+		}
+		if orderID, _ := transaction.Metadata.Get("order_id"); orderID != float64(42) {
+			t.Errorf("expected the metadata to be decoded, got %v", transaction.Metadata)
+		}
+		if calls != 4 {
+			t.Errorf("expected 4 checks, got %d", calls)
+		}
+		if len(seen) != 2 || seen[0] != TransactionOngoing || seen[1] != TransactionProcessing {
+			t.Errorf("expected a callback per status change, got %v", seen)
+		}
+	})
+
+	t.Run("returns the last status when ctx is done", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"status":true,"data":{"reference":"order-42","status":"pending"}}`))
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		transaction, err := FromConfig(client).Transactions.Wait(ctx, "order-42", &WaitOptions{Interval: 5 * time.Millisecond})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected the deadline to be exceeded, got %v", err)
+		}
+		if transaction == nil || transaction.Status != TransactionPending {
+			t.Errorf("expected the pending transaction, got %+v", transaction)
+		}
+	})
+
+	t.Run("keeps polling through server errors", func(t *testing.T) {
+		calls := 0
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":true,"data":{"status":"failed"}}`))
+		})
+
+		transaction, err := FromConfig(client).Transactions.Wait(context.Background(), "order-42", &WaitOptions{Interval: time.Millisecond})
+		if err != nil || transaction.Status != TransactionFailed {
+			t.Errorf("expected the failed transaction, got %+v %v", transaction, err)
+		}
+	})
+
+	t.Run("stops when the reference is not found", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":false,"message":"Transaction reference not found"}`))
+		})
+
+		_, err := FromConfig(client).Transactions.Wait(context.Background(), "order-42", &WaitOptions{Interval: time.Millisecond})
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected the APIError, got %v", err)
+		}
+	})
+}
+
+func TestVerifyEscapesReference(t *testing.T) {
+	client := FromConfig(newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/transaction/verify/order%2F42" {
+			t.Errorf("unexpected path %s", r.URL.EscapedPath())
+		}
+		_, _ = w.Write([]byte(`{"status":true,"data":{"reference":"order/42","status":"success"}}`))
+	}))
+
+	if _, err := client.Transactions.Verify("order/42"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Transactions.Wait(context.Background(), "order/42", nil); err != nil {
+		t.Fatal(err)
+	}
+}